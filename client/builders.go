@@ -6,10 +6,12 @@ import (
 	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/binary/jce"
 	"github.com/Mrs4s/MiraiGo/client/pb"
+	"github.com/Mrs4s/MiraiGo/client/pb/cmd0x346"
 	"github.com/Mrs4s/MiraiGo/client/pb/cmd0x352"
 	"github.com/Mrs4s/MiraiGo/client/pb/msg"
 	"github.com/Mrs4s/MiraiGo/client/pb/multimsg"
 	"github.com/Mrs4s/MiraiGo/client/pb/oidb"
+	"github.com/Mrs4s/MiraiGo/client/pb/pttcenter"
 	"github.com/Mrs4s/MiraiGo/client/pb/structmsg"
 	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/protocol/crypto"
@@ -410,14 +412,15 @@ func (c *QQClient) buildGroupSendingPacket(groupCode int64, r int32, forward boo
 }
 
 // MessageSvc.PbSendMsg
-func (c *QQClient) buildFriendSendingPacket(target int64, msgSeq, r int32, time int64, m *message.SendingMessage) (uint16, []byte) {
+func (c *QQClient) buildFriendSendingPacket(target int64, msgSeq, r int32, time int64, forward bool, m *message.SendingMessage) (uint16, []byte) {
 	seq := c.nextSeq()
 	req := &msg.SendMessageRequest{
 		RoutingHead: &msg.RoutingHead{C2C: &msg.C2C{ToUin: target}},
 		ContentHead: &msg.ContentHead{PkgNum: 1},
 		MsgBody: &msg.MessageBody{
 			RichText: &msg.RichText{
-				Elems: message.ToProtoElems(m.Elements, false),
+				Elems:         message.ToProtoElems(m.Elements, false),
+				NotOnlineFile: message.ToNotOnlineFile(m.Elements),
 			},
 		},
 		MsgSeq:  msgSeq,
@@ -434,6 +437,12 @@ func (c *QQClient) buildFriendSendingPacket(target int64, msgSeq, r int32, time
 			b, _ := proto.Marshal(cookie)
 			return b
 		}(),
+		MsgCtrl: func() *msg.MsgCtrl {
+			if forward {
+				return &msg.MsgCtrl{MsgFlag: 4}
+			}
+			return nil
+		}(),
 	}
 	payload, _ := proto.Marshal(req)
 	packet := packets.BuildUniPacket(c.Uin, seq, "MessageSvc.PbSendMsg", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
@@ -500,50 +509,46 @@ func (c *QQClient) buildGroupImageStorePacket(groupCode int64, md5 []byte, size
 	return seq, packet
 }
 
-func (c *QQClient) buildImageUploadPacket(data, updKey []byte, commandId int32, fmd5 [16]byte) (r [][]byte) {
-	offset := 0
-	binary.ToChunkedBytesF(data, 8192*1024, func(chunked []byte) {
-		w := binary.NewWriter()
-		cmd5 := md5.Sum(chunked)
-		head, _ := proto.Marshal(&pb.ReqDataHighwayHead{
-			MsgBasehead: &pb.DataHighwayHead{
-				Version: 1,
-				Uin:     strconv.FormatInt(c.Uin, 10),
-				Command: "PicUp.DataUp",
-				Seq: func() int32 {
-					if commandId == 2 {
-						return c.nextGroupDataTransSeq()
-					}
-					if commandId == 27 {
-						return c.nextHighwayApplySeq()
-					}
+// buildHighwayDataPacket 构建单个分块的 highway 上传包, offset 为该分块在文件中的偏移
+func (c *QQClient) buildHighwayDataPacket(chunk, updKey, ext []byte, commandId int32, fileSize, offset int64, fmd5 [16]byte) []byte {
+	w := binary.NewWriter()
+	cmd5 := md5.Sum(chunk)
+	head, _ := proto.Marshal(&pb.ReqDataHighwayHead{
+		MsgBasehead: &pb.DataHighwayHead{
+			Version: 1,
+			Uin:     strconv.FormatInt(c.Uin, 10),
+			Command: "PicUp.DataUp",
+			Seq: func() int32 {
+				if commandId == 2 {
 					return c.nextGroupDataTransSeq()
-				}(),
-				Appid:     537062409,
-				Dataflag:  4096,
-				CommandId: commandId,
-				LocaleId:  2052,
-			},
-			MsgSeghead: &pb.SegHead{
-				Filesize:      int64(len(data)),
-				Dataoffset:    int64(offset),
-				Datalength:    int32(len(chunked)),
-				Serviceticket: updKey,
-				Md5:           cmd5[:],
-				FileMd5:       fmd5[:],
-			},
-			ReqExtendinfo: EmptyBytes,
-		})
-		offset += len(chunked)
-		w.WriteByte(40)
-		w.WriteUInt32(uint32(len(head)))
-		w.WriteUInt32(uint32(len(chunked)))
-		w.Write(head)
-		w.Write(chunked)
-		w.WriteByte(41)
-		r = append(r, w.Bytes())
+				}
+				if commandId == 27 {
+					return c.nextHighwayApplySeq()
+				}
+				return c.nextGroupDataTransSeq()
+			}(),
+			Appid:     537062409,
+			Dataflag:  4096,
+			CommandId: commandId,
+			LocaleId:  2052,
+		},
+		MsgSeghead: &pb.SegHead{
+			Filesize:      fileSize,
+			Dataoffset:    offset,
+			Datalength:    int32(len(chunk)),
+			Serviceticket: updKey,
+			Md5:           cmd5[:],
+			FileMd5:       fmd5[:],
+		},
+		ReqExtendinfo: ext,
 	})
-	return
+	w.WriteByte(40)
+	w.WriteUInt32(uint32(len(head)))
+	w.WriteUInt32(uint32(len(chunk)))
+	w.Write(head)
+	w.Write(chunk)
+	w.WriteByte(41)
+	return w.Bytes()
 }
 
 // ProfileService.Pb.ReqSystemMsgNew.Group
@@ -780,6 +785,55 @@ func (c *QQClient) buildGroupMuteAllPacket(groupCode int64, mute bool) (uint16,
 	return c.buildGroupOperationPacket(body)
 }
 
+// OidbSvc.0x89a_0
+// 只写入与 origin 不同的字段, 未改动的设置保持不变
+func (c *QQClient) buildGroupSettingsUpdatePacket(groupCode int64, s, origin *GroupSettings) (uint16, []byte) {
+	info := &oidb.D89AGroupinfo{}
+	if s.Memo != origin.Memo {
+		info.IngGroupMemo = []byte(s.Memo)
+	}
+	if s.JoinPolicy != origin.JoinPolicy || s.Question != origin.Question || s.Answer != origin.Answer {
+		info.AddOption = int32(s.JoinPolicy)
+		info.IngGroupQuestion = []byte(s.Question)
+		info.IngGroupAnswer = []byte(s.Answer)
+	}
+	if s.AllowMemberInvite != origin.AllowMemberInvite {
+		info.AllowMemberInvite = &oidb.D89AGroupinfo_AllowMemberInviteVal{AllowMemberInviteVal: func() int32 {
+			if s.AllowMemberInvite {
+				return 1
+			}
+			return 0
+		}()}
+	}
+	if s.AllowUploadFile != origin.AllowUploadFile {
+		info.AppPrivilegeFlag = &oidb.D89AGroupinfo_AppPrivilegeFlagVal{AppPrivilegeFlagVal: func() int32 {
+			if s.AllowUploadFile {
+				return 0
+			}
+			return appPrivilegeUploadFileDisabled
+		}()}
+		info.AppPrivilegeMask = appPrivilegeUploadFileDisabled
+	}
+	if s.NewMemberGuidelines != nil {
+		info.StGroupNewguidelines = &oidb.D89AGroupNewGuidelinesInfo{
+			BoolEnabled: s.NewMemberGuidelines.Enabled,
+			IngContent:  []byte(s.NewMemberGuidelines.Content),
+		}
+	}
+	if s.Geo != nil && (origin.Geo == nil || *s.Geo != *origin.Geo) {
+		info.MsgGroupGeoInfo = &oidb.D89AGroupGeoInfo{
+			CityId:        s.Geo.CityId,
+			Longtitude:    s.Geo.Longitude,
+			Latitude:      s.Geo.Latitude,
+			IngGeoContent: []byte(s.Geo.Content),
+		}
+	}
+	return c.buildGroupOperationPacket(&oidb.D89AReqBody{
+		GroupCode:   groupCode,
+		StGroupInfo: info,
+	})
+}
+
 // OidbSvc.0x8a0_0
 func (c *QQClient) buildGroupKickPacket(groupCode, memberUin int64, kickMsg string) (uint16, []byte) {
 	seq := c.nextSeq()
@@ -823,8 +877,25 @@ func (c *QQClient) buildGroupMutePacket(groupCode, memberUin int64, time uint32)
 	return seq, packet
 }
 
+// OidbSvc.0x55c_1
+func (c *QQClient) buildGroupAdminSetPacket(groupCode, memberUin int64, flag bool) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &oidb.OIDBSSOPkg{
+		Command:     1372,
+		ServiceType: 1,
+		Bodybuffer: binary.NewWriterF(func(w *binary.Writer) {
+			w.WriteUInt32(uint32(groupCode))
+			w.WriteUInt32(uint32(memberUin))
+			w.WriteBool(flag)
+		}),
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x55c_1", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
 // MultiMsg.ApplyUp
-func (c *QQClient) buildMultiApplyUpPacket(data, hash []byte, buType int32, groupUin int64) (uint16, []byte) {
+func (c *QQClient) buildMultiApplyUpPacket(data, hash []byte, buType, msgType int32, dstUin int64) (uint16, []byte) {
 	seq := c.nextSeq()
 	req := &multimsg.MultiReqBody{
 		Subcmd:       1,
@@ -834,10 +905,10 @@ func (c *QQClient) buildMultiApplyUpPacket(data, hash []byte, buType int32, grou
 		BuildVer:     "8.2.0.1296",
 		MultimsgApplyupReq: []*multimsg.MultiMsgApplyUpReq{
 			{
-				DstUin:  groupUin,
+				DstUin:  dstUin,
 				MsgSize: int64(len(data)),
 				MsgMd5:  hash,
-				MsgType: 3,
+				MsgType: msgType,
 			},
 		},
 		BuType: buType,
@@ -893,3 +964,501 @@ func (c *QQClient) buildQuitGroupPacket(groupCode int64) (uint16, []byte) {
 	packet := packets.BuildUniPacket(c.Uin, seq, "ProfileService.GroupMngReq", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, pkt.ToBytes())
 	return seq, packet
 }
+
+func (c *QQClient) buildPttShortVideoUploadReq(videoHash, thumbHash []byte, groupCode, videoSize, thumbSize int64, width, height int32) *pttcenter.PttShortVideoUploadReq {
+	return &pttcenter.PttShortVideoUploadReq{
+		Fromuin:    c.Uin,
+		Touin:      groupCode,
+		ChatType:   1,
+		ClientType: 2,
+		Info: &pttcenter.PttShortVideoFileInfo{
+			FileName:      hex.EncodeToString(videoHash) + ".mp4",
+			FileMd5:       videoHash,
+			ThumbFileMd5:  thumbHash,
+			FileSize:      videoSize,
+			FileResLength: width, // 协议中 length 为横向像素, width 为纵向像素, 官方客户端 720p 视频填 1280/720
+			FileResWidth:  height,
+			FileFormat:    3,
+			ThumbFileSize: thumbSize,
+		},
+		GroupCode:            groupCode,
+		AgentType:            0,
+		BusinessType:         1,
+		FlagSupportLargeSize: 1,
+	}
+}
+
+// PttCenterSvc.GroupShortVideoUpReq
+func (c *QQClient) buildPttGroupShortVideoUploadReqPacket(req *pttcenter.PttShortVideoUploadReq) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &pttcenter.ShortVideoReqBody{
+		Cmd:                    300,
+		Seq:                    int32(seq),
+		PttShortVideoUploadReq: req,
+	}
+	payload, _ := proto.Marshal(body)
+	packet := packets.BuildUniPacket(c.Uin, seq, "PttCenterSvc.GroupShortVideoUpReq", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// PttCenterSvc.ShortVideoDownReq
+// chatType 1 为群聊, 0 为私聊; 私聊时 groupCode 为 0
+func (c *QQClient) buildPttShortVideoDownReqPacket(chatType int32, fromUin, groupCode int64, uuid, md5 []byte) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &pttcenter.ShortVideoReqBody{
+		Cmd: 400,
+		Seq: int32(seq),
+		PttShortVideoDownloadReq: &pttcenter.PttShortVideoDownloadReq{
+			FromUin:      fromUin,
+			ToUin:        c.Uin,
+			ChatType:     chatType,
+			ClientType:   7,
+			FileId:       string(uuid),
+			GroupCode:    groupCode,
+			FileMd5:      md5,
+			BusinessType: 1,
+			FileType:     2,
+			DownType:     2,
+			SceneType:    2,
+		},
+	}
+	payload, _ := proto.Marshal(body)
+	packet := packets.BuildUniPacket(c.Uin, seq, "PttCenterSvc.ShortVideoDownReq", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// group_anonymous_generate_nick.group
+func (c *QQClient) buildAnonymousInfoRequestPacket(groupCode int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &pb.AnonymousNickReqBody{
+		Cmd: 1,
+		AnonyReq: &pb.AnonymousNickReq{
+			Uin:       c.Uin,
+			GroupCode: groupCode,
+		},
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "group_anonymous_generate_nick.group", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0xed3
+func (c *QQClient) buildGroupPokePacket(groupCode, target int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.DED3ReqBody{
+		ToUin:     target,
+		GroupCode: groupCode,
+	}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     3795,
+		ServiceType: 1,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0xed3", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0xed3
+func (c *QQClient) buildFriendPokePacket(target int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.DED3ReqBody{
+		ToUin:  target,
+		AioUin: target,
+	}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     3795,
+		ServiceType: 1,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0xed3", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// MessageSvc.PbGetGroupMsg
+func (c *QQClient) buildGetGroupMsgRequest(groupCode, beginSeq, endSeq int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &msg.GetGroupMsgReq{
+		GroupCode:   uint64(groupCode),
+		BeginSeq:    uint64(beginSeq),
+		EndSeq:      uint64(endSeq),
+		PublicGroup: false,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "MessageSvc.PbGetGroupMsg", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.oidb_0x758
+func (c *QQClient) buildGroupInvitePacket(groupCode, uin int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D758ReqBody{
+		JoinGroupCode:    groupCode,
+		BeInvitedUinInfo: []*oidb.D758InviteUinInfo{{Uin: uin}},
+	}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1880,
+		ServiceType: 1,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.oidb_0x758", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d8_1
+func (c *QQClient) buildGroupFileListRequestPacket(groupCode int64, folderId string, startIndex int32) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D8ReqBody{FileListInfoReq: &oidb.D6D8GetFileListReqBody{
+		GroupCode:    groupCode,
+		AppId:        3,
+		FolderId:     folderId,
+		FileCount:    20,
+		AllFileCount: 0,
+		ReqFrom:      3,
+		SortBy:       1,
+		FilterCode:   0,
+		Uin:          0,
+		StartIndex:   startIndex,
+		Context:      EmptyBytes,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1752,
+		ServiceType: 1,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d8_1", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d8_1
+func (c *QQClient) buildGroupFileCountRequestPacket(groupCode int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D8ReqBody{GroupFileCntReq: &oidb.D6D8GetFileCountReqBody{
+		GroupCode: groupCode,
+		AppId:     3,
+		BusId:     0,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1752,
+		ServiceType: 2,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d8_1", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d8_1
+func (c *QQClient) buildGroupFileSpaceRequestPacket(groupCode int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D8ReqBody{GroupSpaceReq: &oidb.D6D8GetSpaceReqBody{
+		GroupCode: groupCode,
+		AppId:     3,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1752,
+		ServiceType: 3,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d8_1", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d6_0
+func (c *QQClient) buildGroupFileUploadReqPacket(groupCode int64, parentFolderId, fileName string, size int64, md5, sha1 []byte) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D6ReqBody{UploadFileReq: &oidb.D6D6UploadFileReqBody{
+		GroupCode:          groupCode,
+		AppId:              3,
+		BusId:              102,
+		Entrance:           5,
+		ParentFolderId:     parentFolderId,
+		FileName:           fileName,
+		LocalPath:          "/storage/emulated/0/Pictures/files/s/" + fileName,
+		Int64FileSize:      size,
+		Sha:                sha1,
+		Md5:                md5,
+		SupportMultiUpload: true,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1750,
+		ServiceType: 0,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d6_0", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d6_2
+func (c *QQClient) buildGroupFileDownloadReqPacket(groupCode int64, fileId string, busId int32) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D6ReqBody{DownloadFileReq: &oidb.D6D6DownloadFileReqBody{
+		GroupCode: groupCode,
+		AppId:     3,
+		BusId:     busId,
+		FileId:    fileId,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1750,
+		ServiceType: 2,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d6_2", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d6_3
+func (c *QQClient) buildGroupFileDeleteReqPacket(groupCode int64, parentFolderId, fileId string, busId int32) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D6ReqBody{DeleteFileReq: &oidb.D6D6DeleteFileReqBody{
+		GroupCode:      groupCode,
+		AppId:          3,
+		BusId:          busId,
+		ParentFolderId: parentFolderId,
+		FileId:         fileId,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1750,
+		ServiceType: 3,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d6_3", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d6_5
+func (c *QQClient) buildGroupFileMoveReqPacket(groupCode int64, fileId, parentFolderId, destFolderId string, busId int32) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D6ReqBody{MoveFileReq: &oidb.D6D6MoveFileReqBody{
+		GroupCode:      groupCode,
+		AppId:          3,
+		BusId:          busId,
+		FileId:         fileId,
+		ParentFolderId: parentFolderId,
+		DestFolderId:   destFolderId,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1750,
+		ServiceType: 5,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d6_5", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d7_0
+func (c *QQClient) buildGroupFileCreateFolderPacket(groupCode int64, parentFolderId, name string) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D7ReqBody{CreateFolderReq: &oidb.D6D7CreateFolderReqBody{
+		GroupCode:      groupCode,
+		AppId:          3,
+		ParentFolderId: parentFolderId,
+		FolderName:     name,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1751,
+		ServiceType: 0,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d7_0", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d7_1
+func (c *QQClient) buildGroupFileDeleteFolderPacket(groupCode int64, folderId string) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D7ReqBody{DeleteFolderReq: &oidb.D6D7DeleteFolderReqBody{
+		GroupCode: groupCode,
+		AppId:     3,
+		FolderId:  folderId,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1751,
+		ServiceType: 1,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d7_1", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d7_2
+func (c *QQClient) buildGroupFileRenameFolderPacket(groupCode int64, folderId, newName string) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D7ReqBody{RenameFolderReq: &oidb.D6D7RenameFolderReqBody{
+		GroupCode:     groupCode,
+		AppId:         3,
+		FolderId:      folderId,
+		NewFolderName: newName,
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1751,
+		ServiceType: 2,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d7_2", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x6d9_4
+func (c *QQClient) buildGroupFileFeedsRequest(groupCode int64, fileId string, busId, msgRand int32) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D6D9ReqBody{FeedsInfoReq: &oidb.D6D9FeedsReqBody{
+		GroupCode: groupCode,
+		AppId:     3,
+		FeedsInfoList: []*oidb.D6D9GroupFileFeedsInfo{{
+			FileId:    fileId,
+			FeedFlag:  1,
+			BusId:     busId,
+			MsgRandom: msgRand,
+		}},
+	}}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     1753,
+		ServiceType: 4,
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x6d9_4", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_DOWNLOAD-1200
+func (c *QQClient) buildOfflineFileDownloadRequestPacket(uuid []byte) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &cmd0x346.C346ReqBody{
+		Cmd:        1200,
+		Seq:        int32(seq),
+		BusinessId: 3,
+		ClientType: 104,
+		ApplyDownloadReq: &cmd0x346.ApplyDownloadReq{
+			Uin:       c.Uin,
+			Uuid:      uuid,
+			OwnerType: 2,
+		},
+		ExtensionReq: &cmd0x346.ExtensionReq{
+			DownloadUrlType: 1,
+		},
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_DOWNLOAD-1200", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_UPLOAD_V3-1700
+func (c *QQClient) buildOfflineFileUploadRequestPacket(target int64, name string, size int64, md5, sha1 []byte) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &cmd0x346.C346ReqBody{
+		Cmd:        1700,
+		Seq:        int32(seq),
+		BusinessId: 3,
+		ClientType: 104,
+		ApplyUploadReqV3: &cmd0x346.ApplyUploadReqV3{
+			SenderUin:     c.Uin,
+			RecverUin:     target,
+			FileSize:      size,
+			FileName:      name,
+			Md5_10M:       md5,
+			Sha:           sha1,
+			LocalFilepath: "/storage/emulated/0/Android/data/com.tencent.mobileqq/Tencent/QQfile_recv/" + name,
+		},
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_UPLOAD_V3-1700", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0x88d_0
+func (c *QQClient) buildGroupInfoRequestPacket(groupCode int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	body := &oidb.D88DReqBody{
+		AppId: proto.Uint32(537062409),
+		ReqGroupInfo: []*oidb.ReqGroupInfo{{
+			GroupCode: proto.Uint64(uint64(groupCode)),
+			Stgroupinfo: &oidb.D88DGroupInfo{
+				GroupOwner:        proto.Uint64(0),
+				GroupMemberMaxNum: proto.Uint32(0),
+				GroupMemberNum:    proto.Uint32(0),
+				GroupName:         EmptyBytes,
+				GroupMemo:         EmptyBytes,
+				GroupUin:          proto.Uint64(0),
+				GroupCurMsgSeq:    proto.Uint32(0),
+				GroupFlagExt:      proto.Uint32(0),
+				GroupOption:       proto.Uint32(0),
+				IngGroupQuestion:  EmptyBytes,
+				IngGroupAnswer:    EmptyBytes,
+				GroupGeoInfo:      &oidb.D88DGroupGeoInfo{},
+				AppPrivilegeFlag:  proto.Uint32(0),
+				GroupFlagext3:     proto.Uint32(0),
+			},
+		}},
+		PcClientVersion: proto.Uint32(0),
+	}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:    2189,
+		Bodybuffer: b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "OidbSvc.0x88d_0", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// OidbSvc.0xeac_1 / OidbSvc.0xeac_2
+func (c *QQClient) buildEssenceMsgOperatePacket(groupCode int64, msgSeq, msgRand int32, opType uint32) (uint16, []byte) {
+	seq := c.nextSeq()
+	commandName := "OidbSvc.0xeac_" + strconv.FormatInt(int64(opType), 10)
+	body := &oidb.EACReqBody{
+		GroupCode: proto.Uint64(uint64(groupCode)),
+		Seq:       proto.Uint32(uint32(msgSeq)),
+		Random:    proto.Uint32(uint32(msgRand)),
+	}
+	b, _ := proto.Marshal(body)
+	req := &oidb.OIDBSSOPkg{
+		Command:     3756,
+		ServiceType: int32(opType),
+		Bodybuffer:  b,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, commandName, 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
+
+// group_member_card.get_group_member_card_info
+func (c *QQClient) buildGroupMemberInfoRequestPacket(groupCode, uin int64) (uint16, []byte) {
+	seq := c.nextSeq()
+	req := &pb.GroupMemberReqBody{
+		GroupCode:       groupCode,
+		Uin:             uin,
+		NewClient:       true,
+		ClientType:      1,
+		RichCardNameVer: 1,
+	}
+	payload, _ := proto.Marshal(req)
+	packet := packets.BuildUniPacket(c.Uin, seq, "group_member_card.get_group_member_card_info", 1, c.OutGoingPacketSessionId, EmptyBytes, c.sigInfo.d2Key, payload)
+	return seq, packet
+}
@@ -1,20 +1,28 @@
 package client
 
 import (
+	"bytes"
 	"crypto/md5"
+	"crypto/sha1"
 	"errors"
 	"fmt"
 	"github.com/Mrs4s/MiraiGo/binary"
+	"github.com/Mrs4s/MiraiGo/client/pb/cmd0x346"
 	"github.com/Mrs4s/MiraiGo/client/pb/longmsg"
 	"github.com/Mrs4s/MiraiGo/client/pb/msg"
 	"github.com/Mrs4s/MiraiGo/client/pb/multimsg"
+	"github.com/Mrs4s/MiraiGo/client/pb/oidb"
+	"github.com/Mrs4s/MiraiGo/client/pb/pttcenter"
 	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/protocol/packets"
 	"github.com/Mrs4s/MiraiGo/utils"
 	"github.com/golang/protobuf/proto"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
 	"io"
 	"log"
-	"math"
 	"math/rand"
 	"net"
 	"strconv"
@@ -58,9 +66,16 @@ type QQClient struct {
 
 	lastMessageSeq         int32
 	lastMessageSeqTmp      sync.Map
+	lastGroupSeq           sync.Map
+	groupSeqLock           sync.Mutex
 	lastLostMsg            string
 	groupMsgBuilders       sync.Map
 	onlinePushCache        []int16 // reset on reconnect
+	c2cMessageCache        *utils.TTList
+	groupMsgSeqList        *utils.TTList
+	c2cMsgSeqList          *utils.TTList
+	sentMsgRandList        *utils.TTList
+	messageStore           MessageStore
 	requestPacketRequestId int32
 	groupSeq               int32
 	friendSeq              int32
@@ -107,10 +122,23 @@ func NewClientMd5(uin int64, passwordMd5 [16]byte) *QQClient {
 			"StatSvc.ReqMSFOffline":                    decodeMSFOfflinePacket,
 			"MessageSvc.PushNotify":                    decodeSvcNotify,
 			"OnlinePush.PbPushGroupMsg":                decodeGroupMessagePacket,
+			"MessageSvc.PbGetGroupMsg":                 decodeGetGroupMsgResponse,
+			"OidbSvc.oidb_0x758":                       decodeOIDBResultResponse,
+			"OidbSvc.0x55c_1":                          decodeOIDBResultResponse,
+			"OidbSvc.0x6d6_0":                          decodeOIDB6d6Response,
+			"OidbSvc.0x6d6_2":                          decodeOIDB6d6Response,
+			"OidbSvc.0x6d6_3":                          decodeOIDB6d6Response,
+			"OidbSvc.0x6d6_5":                          decodeOIDB6d6Response,
+			"OidbSvc.0x6d7_0":                          decodeOIDB6d7Response,
+			"OidbSvc.0x6d7_1":                          decodeOIDB6d7Response,
+			"OidbSvc.0x6d7_2":                          decodeOIDB6d7Response,
+			"OidbSvc.0x6d8_1":                          decodeOIDB6d8Response,
+			"OidbSvc.0x6d9_4":                          decodeOIDB6d9Response,
 			"OnlinePush.ReqPush":                       decodeOnlinePushReqPacket,
 			"OnlinePush.PbPushTransMsg":                decodeOnlinePushTransPacket,
 			"ConfigPushSvc.PushReq":                    decodePushReqPacket,
 			"MessageSvc.PbGetMsg":                      decodeMessageSvcPacket,
+			"MessageSvc.PbSendMsg":                     decodeMsgSendResponse,
 			"MessageSvc.PushForceOffline":              decodeForceOfflinePacket,
 			"friendlist.getFriendGroupList":            decodeFriendGroupListResponse,
 			"friendlist.GetTroopListReqV2":             decodeGroupListResponse,
@@ -121,6 +149,18 @@ func NewClientMd5(uin int64, passwordMd5 [16]byte) *QQClient {
 			"ProfileService.Pb.ReqSystemMsgNew.Friend": decodeSystemMsgFriendPacket,
 			"MultiMsg.ApplyUp":                         decodeMultiApplyUpResponse,
 			"MultiMsg.ApplyDown":                       decodeMultiApplyDownResponse,
+			"PttCenterSvc.GroupShortVideoUpReq":        decodeGroupShortVideoUploadResponse,
+			"PttCenterSvc.ShortVideoDownReq":           decodePttShortVideoDownResponse,
+			"group_anonymous_generate_nick.group":      decodeAnonymousInfoResponse,
+			"OidbSvc.0x88d_0":                          decodeGroupInfoResponse,
+			"OidbSvc.0x89a_0":                          decodeOIDBResultResponse,
+			"OidbSvc.0xed3":                            decodeOIDBResultResponse,
+			"OidbSvc.0xeac_1":                          decodeEssenceMsgResponse,
+			"OidbSvc.0xeac_2":                          decodeEssenceMsgResponse,
+
+			"OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_DOWNLOAD-1200":  decodeOfflineFileResponse,
+			"OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_UPLOAD_V3-1700": decodeOfflineFileResponse,
+			"group_member_card.get_group_member_card_info":              decodeGroupMemberInfoResponse,
 		},
 		sigInfo:                &loginSigInfo{},
 		requestPacketRequestId: 1921334513,
@@ -130,6 +170,11 @@ func NewClientMd5(uin int64, passwordMd5 [16]byte) *QQClient {
 		ksid:                   []byte("|454001228437590|A8.2.7.27f6ea96"),
 		eventHandlers:          &eventHandlers{},
 		groupListLock:          new(sync.Mutex),
+		c2cMessageCache:        utils.NewTTList(600),
+		groupMsgSeqList:        utils.NewTTList(600),
+		c2cMsgSeqList:          utils.NewTTList(600),
+		sentMsgRandList:        utils.NewTTList(600),
+		messageStore:           NewLRUMessageStore(4096),
 	}
 	rand.Read(cli.RandomKey)
 	return cli
@@ -154,9 +199,11 @@ func (c *QQClient) Login() (*LoginResponse, error) {
 	l := rsp.(LoginResponse)
 	if l.Success {
 		c.lastLostMsg = ""
+		snapshot := c.snapshotGroupSeq() // 同一客户端断线后重新登录时补拉
 		c.registerClient()
 		go c.heartbeat()
 		_, _ = c.sendAndWait(c.buildGetMessageRequestPacket(msg.SyncFlag_START, time.Now().Unix()))
+		go c.catchUpGroupMessages(snapshot)
 	}
 	return &l, nil
 }
@@ -170,8 +217,10 @@ func (c *QQClient) SubmitCaptcha(result string, sign []byte) (*LoginResponse, er
 	}
 	l := rsp.(LoginResponse)
 	if l.Success {
+		snapshot := c.snapshotGroupSeq()
 		c.registerClient()
 		go c.heartbeat()
+		go c.catchUpGroupMessages(snapshot)
 	}
 	return &l, nil
 }
@@ -206,23 +255,25 @@ func (c *QQClient) GetFriendList() (*FriendListResponse, error) {
 	return r, nil
 }
 
+// SendGroupMessage 发送群消息, 超出单条限制时按 m.Strategy 处理. 拆分发送时返回最后一条消息
 func (c *QQClient) SendGroupMessage(groupCode int64, m *message.SendingMessage) *message.GroupMessage {
-	imgCount := m.Count(func(e message.IMessageElement) bool { return e.Type() == message.Image })
-	msgLen := message.EstimateLength(m.Elements, 703)
-	if msgLen > 5000 || imgCount > 50 {
-		return nil
+	if !m.Exceeded() {
+		return c.sendGroupMessage(groupCode, false, m)
 	}
-	if msgLen > 702 || imgCount > 2 {
-		return c.sendGroupLongOrForwardMessage(groupCode, true, &message.ForwardMessage{Nodes: []*message.ForwardNode{
-			{
-				SenderId:   c.Uin,
-				SenderName: c.Nickname,
-				Time:       int32(time.Now().Unix()),
-				Message:    m.Elements,
-			},
-		}})
+	if m.Strategy == message.StrategySplit {
+		if parts, err := m.Split(); err == nil {
+			var ret *message.GroupMessage
+			for _, part := range parts {
+				ret = c.sendGroupMessage(groupCode, false, part)
+			}
+			return ret
+		}
+		// 存在无法拆分的元素, 转为合并转发
+	}
+	if m.Strategy != message.StrategyLong || m.ExceededLong() {
+		return c.sendGroupLongOrForwardMessage(groupCode, false, c.splitToForward(m))
 	}
-	return c.sendGroupMessage(groupCode, false, m)
+	return c.sendGroupLongOrForwardMessage(groupCode, true, c.toSingleNodeForward(m))
 }
 
 func (c *QQClient) sendGroupMessage(groupCode int64, forward bool, m *message.SendingMessage) *message.GroupMessage {
@@ -235,6 +286,7 @@ func (c *QQClient) sendGroupMessage(groupCode int64, forward bool, m *message.Se
 		}
 	})
 	defer c.onGroupMessageReceipt(eid)
+	c.sentMsgRandList.Add(mr)
 	_, pkt := c.buildGroupSendingPacket(groupCode, mr, forward, m)
 	_ = c.send(pkt)
 	var mid int32
@@ -256,17 +308,74 @@ func (c *QQClient) sendGroupMessage(groupCode int64, forward bool, m *message.Se
 		return ret
 	}
 	ret.Id = mid
+	logStoreError(c.messageStore.SaveGroupMessage(ret))
 	return ret
 }
 
+// SendPrivateMessage 发送好友消息, 超出单条限制时按 m.Strategy 处理. 拆分发送时返回最后一条消息
 func (c *QQClient) SendPrivateMessage(target int64, m *message.SendingMessage) *message.PrivateMessage {
+	if !m.Exceeded() {
+		return c.sendPrivateMessage(target, false, m)
+	}
+	if m.Strategy == message.StrategySplit {
+		if parts, err := m.Split(); err == nil {
+			var ret *message.PrivateMessage
+			for _, part := range parts {
+				ret = c.sendPrivateMessage(target, false, part)
+			}
+			return ret
+		}
+		// 存在无法拆分的元素, 转为合并转发
+	}
+	if m.Strategy != message.StrategyLong || m.ExceededLong() {
+		return c.sendPrivateLongOrForwardMessage(target, false, c.splitToForward(m))
+	}
+	return c.sendPrivateLongOrForwardMessage(target, true, c.toSingleNodeForward(m))
+}
+
+func (c *QQClient) toSingleNodeForward(m *message.SendingMessage) *message.ForwardMessage {
+	return message.NewForwardMessage().AddNode(message.NewForwardNode(c.Uin, c.Nickname, int32(time.Now().Unix()), m.Elements...))
+}
+
+// splitToForward 将消息拆分后作为多个节点放入合并转发
+func (c *QQClient) splitToForward(m *message.SendingMessage) *message.ForwardMessage {
+	ret := message.NewForwardMessage()
+	ts := int32(time.Now().Unix())
+	parts, err := m.Split()
+	if err != nil { // 合并转发节点不受单条消息限制
+		return ret.AddNode(message.NewForwardNode(c.Uin, c.Nickname, ts, m.Elements...))
+	}
+	for _, part := range parts {
+		ret.AddNode(message.NewForwardNode(c.Uin, c.Nickname, ts, part.Elements...))
+	}
+	return ret
+}
+
+func (c *QQClient) sendPrivateMessage(target int64, forward bool, m *message.SendingMessage) *message.PrivateMessage {
+	ret, _, pkt := c.buildPrivateMessage(target, forward, m)
+	_ = c.send(pkt)
+	c.cachePrivateMessage(ret)
+	return ret
+}
+
+// sendPrivateMessageAndWait 发送私聊消息并等待服务器返回发送结果
+func (c *QQClient) sendPrivateMessageAndWait(target int64, forward bool, m *message.SendingMessage) (*message.PrivateMessage, error) {
+	ret, seq, pkt := c.buildPrivateMessage(target, forward, m)
+	if _, err := c.sendAndWait(seq, pkt); err != nil {
+		return nil, err
+	}
+	c.cachePrivateMessage(ret)
+	return ret, nil
+}
+
+func (c *QQClient) buildPrivateMessage(target int64, forward bool, m *message.SendingMessage) (*message.PrivateMessage, uint16, []byte) {
 	mr := int32(rand.Uint32())
-	seq := c.nextFriendSeq()
+	msgSeq := c.nextFriendSeq()
 	t := time.Now().Unix()
-	_, pkt := c.buildFriendSendingPacket(target, seq, mr, t, m)
-	_ = c.send(pkt)
+	c.sentMsgRandList.Add(mr)
+	seq, pkt := c.buildFriendSendingPacket(target, msgSeq, mr, t, forward, m)
 	return &message.PrivateMessage{
-		Id:         seq,
+		Id:         msgSeq,
 		InternalId: mr,
 		Target:     target,
 		Time:       int32(t),
@@ -276,30 +385,31 @@ func (c *QQClient) SendPrivateMessage(target int64, m *message.SendingMessage) *
 			IsFriend: true,
 		},
 		Elements: m.Elements,
-	}
+	}, seq, pkt
+}
+
+func (c *QQClient) cachePrivateMessage(m *message.PrivateMessage) {
+	c.c2cMessageCache.Add(m)
+	logStoreError(c.messageStore.SavePrivateMessage(m.Target, m))
 }
 
-func (c *QQClient) GetForwardMessage(resId string) *message.ForwardMessage {
+func (c *QQClient) GetForwardMessage(resId string) (*message.ForwardMessage, error) {
 	i, err := c.sendAndWait(c.buildMultiApplyDownPacket(resId))
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	multiMsg := i.(*msg.PbMultiMsgTransmit)
-	ret := &message.ForwardMessage{}
-	for _, m := range multiMsg.Msg {
-		ret.Nodes = append(ret.Nodes, &message.ForwardNode{
-			SenderId: m.Head.FromUin,
-			SenderName: func() string {
-				if m.Head.MsgType == 82 {
-					return m.Head.GroupInfo.GroupCard
-				}
-				return m.Head.FromNick
-			}(),
-			Time:    m.Head.MsgTime,
-			Message: message.ParseMessageElems(m.Body.RichText.Elems),
-		})
+	multiMsg, ok := i.(*msg.PbMultiMsgTransmit)
+	if !ok || multiMsg == nil {
+		return nil, errors.New("forward message not found")
 	}
-	return ret
+	items := make(map[string][]*msg.Message)
+	for _, item := range multiMsg.PbItemList {
+		n := msg.PbMultiMsgNew{}
+		if err := proto.Unmarshal(item.Buffer, &n); err == nil {
+			items[item.FileName] = n.Msg
+		}
+	}
+	return parseForwardMessage(multiMsg.Msg, items), nil
 }
 
 func (c *QQClient) SendGroupForwardMessage(groupCode int64, m *message.ForwardMessage) *message.GroupMessage {
@@ -313,16 +423,46 @@ func (c *QQClient) sendGroupLongOrForwardMessage(groupCode int64, isLong bool, m
 	ts := time.Now().Unix()
 	seq := c.nextGroupSeq()
 	data, hash := m.CalculateValidationData(seq, rand.Int31(), groupCode)
-	i, err := c.sendAndWait(c.buildMultiApplyUpPacket(data, hash, func() int32 {
-		if isLong {
-			return 1
-		} else {
-			return 2
-		}
-	}(), utils.ToGroupUin(groupCode)))
+	resId, err := c.uploadMultiMessage(utils.ToGroupUin(groupCode), 3, isLong, data, hash)
 	if err != nil {
 		return nil
 	}
+	if !isLong {
+		return c.sendGroupMessage(groupCode, true, genForwardTemplate(resId, forwardPreview(m), "群聊的聊天记录", "[聊天记录]", "聊天记录", fmt.Sprintf("查看 %d 条转发消息", len(m.Nodes)), ts))
+	}
+	return c.sendGroupMessage(groupCode, false, genLongTemplate(resId, longMessageBrief(m), ts))
+}
+
+func (c *QQClient) SendPrivateForwardMessage(target int64, m *message.ForwardMessage) *message.PrivateMessage {
+	return c.sendPrivateLongOrForwardMessage(target, false, m)
+}
+
+func (c *QQClient) sendPrivateLongOrForwardMessage(target int64, isLong bool, m *message.ForwardMessage) *message.PrivateMessage {
+	if len(m.Nodes) >= 200 {
+		return nil
+	}
+	ts := time.Now().Unix()
+	data, hash := m.CalculateValidationData(c.nextFriendSeq(), rand.Int31(), target)
+	resId, err := c.uploadMultiMessage(target, 1, isLong, data, hash)
+	if err != nil {
+		return nil
+	}
+	if !isLong {
+		return c.sendPrivateMessage(target, true, genForwardTemplate(resId, forwardPreview(m), "聊天记录", "[聊天记录]", "聊天记录", fmt.Sprintf("查看 %d 条转发消息", len(m.Nodes)), ts))
+	}
+	return c.sendPrivateMessage(target, false, genLongTemplate(resId, longMessageBrief(m), ts))
+}
+
+// uploadMultiMessage 上传合并转发/长消息内容, msgType 群聊为 3, 私聊为 1
+func (c *QQClient) uploadMultiMessage(dstUin int64, msgType int32, isLong bool, data, hash []byte) (string, error) {
+	buType := int32(2)
+	if isLong {
+		buType = 1
+	}
+	i, err := c.sendAndWait(c.buildMultiApplyUpPacket(data, hash, buType, msgType, dstUin))
+	if err != nil {
+		return "", err
+	}
 	rsp := i.(*multimsg.MultiMsgApplyUpRsp)
 	body, _ := proto.Marshal(&longmsg.LongReqBody{
 		Subcmd:       1,
@@ -330,8 +470,8 @@ func (c *QQClient) sendGroupLongOrForwardMessage(groupCode int64, isLong bool, m
 		PlatformType: 9,
 		MsgUpReq: []*longmsg.LongMsgUpReq{
 			{
-				MsgType:    3,
-				DstUin:     utils.ToGroupUin(groupCode),
+				MsgType:    msgType,
+				DstUin:     dstUin,
 				MsgContent: data,
 				StoreType:  2,
 				MsgUkey:    rsp.MsgUkey,
@@ -340,29 +480,15 @@ func (c *QQClient) sendGroupLongOrForwardMessage(groupCode int64, isLong bool, m
 	})
 	for i, ip := range rsp.Uint32UpIp {
 		updServer := binary.UInt32ToIPV4Address(uint32(ip))
-		err := c.highwayUploadImage(updServer+":"+strconv.FormatInt(int64(rsp.Uint32UpPort[i]), 10), rsp.MsgSig, body, 27)
+		err = c.highwayUploadImage(updServer+":"+strconv.FormatInt(int64(rsp.Uint32UpPort[i]), 10), rsp.MsgSig, body, 27)
 		if err == nil {
-			if !isLong {
-				var pv string
-				for i := 0; i < int(math.Min(4, float64(len(m.Nodes)))); i++ {
-					pv += fmt.Sprintf(`<title size="26" color="#777777">%s: %s</title>`, m.Nodes[i].SenderName, message.ToReadableString(m.Nodes[i].Message))
-				}
-				return c.sendGroupMessage(groupCode, true, genForwardTemplate(rsp.MsgResid, pv, "群聊的聊天记录", "[聊天记录]", "聊天记录", fmt.Sprintf("查看 %d 条转发消息", len(m.Nodes)), ts))
-			}
-			bri := func() string {
-				var r string
-				for _, n := range m.Nodes {
-					r += message.ToReadableString(n.Message)
-					if len(r) >= 27 {
-						break
-					}
-				}
-				return r
-			}()
-			return c.sendGroupMessage(groupCode, false, genLongTemplate(rsp.MsgResid, bri, ts))
+			return rsp.MsgResid, nil
 		}
 	}
-	return nil
+	if err == nil {
+		err = errors.New("no upload server")
+	}
+	return "", err
 }
 
 func (c *QQClient) RecallGroupMessage(groupCode int64, msgId, msgInternalId int32) {
@@ -370,6 +496,63 @@ func (c *QQClient) RecallGroupMessage(groupCode int64, msgId, msgInternalId int3
 	_ = c.send(pkt)
 }
 
+// GetGroupMessages 拉取群漫游消息, 返回 fromSeq 起最多 count 条消息
+func (c *QQClient) GetGroupMessages(groupCode, fromSeq, count int64) ([]*message.GroupMessage, error) {
+	if count <= 0 {
+		return nil, nil
+	}
+	i, err := c.sendAndWait(c.buildGetGroupMsgRequest(groupCode, fromSeq, fromSeq+count-1))
+	if err != nil {
+		return nil, err
+	}
+	return c.parseGroupMessages(i.(*msg.GetGroupMsgResp).Msg, make(map[int32]*groupMessageBuilder)), nil
+}
+
+// SetMessageStore 替换消息存储, 默认为容量 4096 的 LRUMessageStore
+func (c *QQClient) SetMessageStore(s MessageStore) {
+	c.messageStore = s
+}
+
+func (c *QQClient) GetGroupMessageById(groupCode int64, id int32) (*message.GroupMessage, error) {
+	return c.messageStore.LoadGroupMessage(groupCode, id)
+}
+
+func (c *QQClient) GetPrivateMessageById(peer int64, id int32) (*message.PrivateMessage, error) {
+	return c.messageStore.LoadPrivateMessage(peer, id)
+}
+
+func (c *QQClient) GetTempMessageById(peer int64, id int32) (*message.TempMessage, error) {
+	return c.messageStore.LoadTempMessage(peer, id)
+}
+
+// ReplyTo 根据消息 id 生成对群消息的回复
+func (c *QQClient) ReplyTo(groupCode int64, id int32) (*message.ReplyElement, error) {
+	m, err := c.GetGroupMessageById(groupCode, id)
+	if err != nil {
+		return nil, err
+	}
+	return message.NewReply(m), nil
+}
+
+func (c *QQClient) RecallById(groupCode int64, id int32) error {
+	m, err := c.GetGroupMessageById(groupCode, id)
+	if err != nil {
+		return err
+	}
+	c.RecallGroupMessage(groupCode, m.Id, m.InternalId)
+	return nil
+}
+
+func (c *QQClient) SendGroupPoke(groupCode, target int64) error {
+	_, err := c.sendAndWait(c.buildGroupPokePacket(groupCode, target))
+	return err
+}
+
+func (c *QQClient) SendFriendPoke(target int64) error {
+	_, err := c.sendAndWait(c.buildFriendPokePacket(target))
+	return err
+}
+
 func (c *QQClient) UploadGroupImage(groupCode int64, img []byte) (*message.GroupImageElement, error) {
 	h := md5.Sum(img)
 	seq, pkt := c.buildGroupImageStorePacket(groupCode, h[:], int32(len(img)))
@@ -395,10 +578,189 @@ func (c *QQClient) UploadGroupImage(groupCode int64, img []byte) (*message.Group
 	return nil, errors.New("upload failed")
 }
 
+// UploadGroupImageWithBizType 上传群图片并设置图片子类型, 如 message.StickerImage
+func (c *QQClient) UploadGroupImageWithBizType(groupCode int64, img []byte, bizType message.ImageBizType) (*message.GroupImageElement, error) {
+	e, err := c.UploadGroupImage(groupCode, img)
+	if err != nil {
+		return nil, err
+	}
+	e.ImageBizType = bizType
+	return e, nil
+}
+
+// UploadGroupFlashImage 上传群闪照
+func (c *QQClient) UploadGroupFlashImage(groupCode int64, img []byte) (*message.GroupFlashImgElement, error) {
+	e, err := c.UploadGroupImage(groupCode, img)
+	if err != nil {
+		return nil, err
+	}
+	return message.NewGroupFlashImage(e), nil
+}
+
+// UploadGroupShowPic 上传群秀图, effectId 为 message.ShowPicNormal 等
+func (c *QQClient) UploadGroupShowPic(groupCode int64, img []byte, effectId int32) (*message.GroupShowPicElement, error) {
+	e, err := c.UploadGroupImage(groupCode, img)
+	if err != nil {
+		return nil, err
+	}
+	return message.NewGroupShowPic(e, effectId), nil
+}
+
+// UploadGroupShortVideo 上传群短视频, 分辨率由缩略图推断.
+// 私聊短视频使用不同的上传命令和请求结构, 暂不支持
+func (c *QQClient) UploadGroupShortVideo(groupCode int64, video, thumb []byte) (*message.ShortVideoElement, error) {
+	videoHash, thumbHash := md5.Sum(video), md5.Sum(thumb)
+	var width, height int32 = 1280, 720
+	if cfg, _, err := image.DecodeConfig(bytes.NewReader(thumb)); err == nil && cfg.Width > 0 && cfg.Height > 0 {
+		width, height = int32(cfg.Width), int32(cfg.Height)
+	}
+	req := c.buildPttShortVideoUploadReq(videoHash[:], thumbHash[:], groupCode, int64(len(video)), int64(len(thumb)), width, height)
+	i, err := c.sendAndWait(c.buildPttGroupShortVideoUploadReqPacket(req))
+	if err != nil {
+		return nil, err
+	}
+	rsp := i.(*pttcenter.PttShortVideoUploadResp)
+	elem := &message.ShortVideoElement{
+		Size:      int32(len(video)),
+		ThumbSize: int32(len(thumb)),
+		Md5:       videoHash[:],
+		ThumbMd5:  thumbHash[:],
+		Width:     width,
+		Height:    height,
+	}
+	if rsp.FileExist == 1 {
+		elem.Uuid = []byte(rsp.FileId)
+		return elem, nil
+	}
+	ext, _ := proto.Marshal(req)
+	data := append(append([]byte{}, thumb...), video...)
+	for _, addr := range rsp.SameAreaOutAddr {
+		updServer := binary.UInt32ToIPV4Address(uint32(addr.Ip))
+		hwRsp, err := c.highwayUpload(updServer+":"+strconv.FormatInt(int64(addr.Port), 10), rsp.Ukey, data, ext, 25)
+		if err != nil {
+			continue
+		}
+		upRsp := pttcenter.PttShortVideoUploadResp{}
+		if err = proto.Unmarshal(hwRsp, &upRsp); err != nil || upRsp.FileId == "" {
+			continue
+		}
+		elem.Uuid = []byte(upRsp.FileId)
+		return elem, nil
+	}
+	return nil, errors.New("upload failed")
+}
+
+// GetFriendFileUrl 获取好友离线文件的下载链接
+func (c *QQClient) GetFriendFileUrl(e *message.FriendFileElement) (string, error) {
+	i, err := c.sendAndWait(c.buildOfflineFileDownloadRequestPacket(e.Uuid))
+	if err != nil {
+		return "", err
+	}
+	rsp := i.(*cmd0x346.C346RspBody).ApplyDownloadRsp
+	if rsp == nil || rsp.DownloadInfo == nil {
+		return "", errors.New("download rsp is empty")
+	}
+	return "http://" + rsp.DownloadInfo.DownloadIp + ":" + strconv.FormatInt(int64(rsp.DownloadInfo.Port), 10) + rsp.DownloadInfo.DownloadUrl + "&isthumb=0", nil
+}
+
+// SendPrivateFile 以离线文件发送给好友, r 需要支持 Seek 以便计算校验值后重新读取
+func (c *QQClient) SendPrivateFile(target int64, name string, r io.ReadSeeker) (*message.PrivateMessage, error) {
+	mh, sh, h10M := md5.New(), sha1.New(), md5.New()
+	size, err := io.Copy(io.MultiWriter(mh, sh), io.TeeReader(io.LimitReader(r, 10*1024*1024), h10M))
+	if err != nil {
+		return nil, err
+	}
+	n, err := io.Copy(io.MultiWriter(mh, sh), r)
+	if err != nil {
+		return nil, err
+	}
+	size += n
+	var h [16]byte
+	copy(h[:], mh.Sum(nil))
+	i, err := c.sendAndWait(c.buildOfflineFileUploadRequestPacket(target, name, size, h10M.Sum(nil), sh.Sum(nil)))
+	if err != nil {
+		return nil, err
+	}
+	rsp := i.(*cmd0x346.C346RspBody).ApplyUploadRspV3
+	if rsp == nil {
+		return nil, errors.New("upload rsp is empty")
+	}
+	if !rsp.BoolFileExist {
+		err = errors.New("no upload server")
+		for _, ip := range append([]string{rsp.UploadIp}, rsp.UploadIpList...) {
+			if ip == "" {
+				continue
+			}
+			if _, err = r.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if _, err = c.highwayUploadStream(ip+":"+strconv.FormatInt(int64(rsp.UploadPort), 10), rsp.UploadKey, r, size, h, EmptyBytes, 69); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	f := &message.FriendFileElement{
+		Name:       name,
+		Size:       size,
+		Uuid:       rsp.Uuid,
+		Md5:        h[:],
+		ExpireTime: int32(time.Now().Add(time.Hour * 24 * 7).Unix()),
+	}
+	return c.sendPrivateMessageAndWait(target, false, &message.SendingMessage{Elements: []message.IMessageElement{f}})
+}
+
+// GetGroupShortVideoUrl 获取群短视频的下载链接
+func (c *QQClient) GetGroupShortVideoUrl(groupCode int64, uuid, md5 []byte) (string, error) {
+	return c.getShortVideoUrl(1, c.Uin, groupCode, uuid, md5)
+}
+
+// GetPrivateShortVideoUrl 获取私聊短视频的下载链接, sender 为视频发送者
+func (c *QQClient) GetPrivateShortVideoUrl(sender int64, uuid, md5 []byte) (string, error) {
+	return c.getShortVideoUrl(0, sender, 0, uuid, md5)
+}
+
+func (c *QQClient) getShortVideoUrl(chatType int32, fromUin, groupCode int64, uuid, md5 []byte) (string, error) {
+	i, err := c.sendAndWait(c.buildPttShortVideoDownReqPacket(chatType, fromUin, groupCode, uuid, md5))
+	if err != nil {
+		return "", err
+	}
+	return i.(string), nil
+}
+
+func (c *QQClient) GetAnonymousInfo(groupCode int64) (*message.AnonymousInfo, error) {
+	i, err := c.sendAndWait(c.buildAnonymousInfoRequestPacket(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	return i.(*message.AnonymousInfo), nil
+}
+
 func (c *QQClient) UploadPrivateImage(target int64, img []byte) (*message.FriendImageElement, error) {
 	return c.uploadPrivateImage(target, img, 0)
 }
 
+// UploadPrivateImageWithBizType 上传好友图片并设置图片子类型, 如 message.StickerImage
+func (c *QQClient) UploadPrivateImageWithBizType(target int64, img []byte, bizType message.ImageBizType) (*message.FriendImageElement, error) {
+	e, err := c.UploadPrivateImage(target, img)
+	if err != nil {
+		return nil, err
+	}
+	e.ImageBizType = bizType
+	return e, nil
+}
+
+// UploadPrivateFlashImage 上传好友闪照
+func (c *QQClient) UploadPrivateFlashImage(target int64, img []byte) (*message.FriendFlashImgElement, error) {
+	e, err := c.UploadPrivateImage(target, img)
+	if err != nil {
+		return nil, err
+	}
+	return message.NewFriendFlashImage(e), nil
+}
+
 func (c *QQClient) uploadPrivateImage(target int64, img []byte, count int) (*message.FriendImageElement, error) {
 	count++
 	h := md5.Sum(img)
@@ -477,6 +839,67 @@ func (c *QQClient) GetGroupList() ([]*GroupInfo, error) {
 	return r, nil
 }
 
+// GetGroupInfo 获取单个群的资料, 若群已在 GroupList 中则同时更新缓存
+func (c *QQClient) GetGroupInfo(groupCode int64) (*GroupInfo, error) {
+	i, err := c.sendAndWait(c.buildGroupInfoRequestPacket(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	rsp := i.(*oidb.D88DGroupInfo)
+	info := &GroupInfo{
+		Uin:            int64(rsp.GetGroupUin()),
+		Code:           groupCode,
+		Name:           string(rsp.GroupName),
+		Memo:           string(rsp.GroupMemo),
+		OwnerUin:       int64(rsp.GetGroupOwner()),
+		MemberCount:    uint16(rsp.GetGroupMemberNum()),
+		MaxMemberCount: uint16(rsp.GetGroupMemberMaxNum()),
+		client:         c,
+	}
+	g := c.FindGroup(groupCode)
+	if g == nil {
+		return info, nil
+	}
+	g.Name = info.Name
+	g.Memo = info.Memo
+	g.OwnerUin = info.OwnerUin
+	g.MemberCount = info.MemberCount
+	g.MaxMemberCount = info.MaxMemberCount
+	return g, nil
+}
+
+// GetGroupMemberInfo 获取单个群成员的资料, 成员已在缓存的成员列表中时同时更新缓存.
+// oidb 0x8fc 只用于修改成员名片/头衔, 不返回成员资料, 因此这里使用客户端查看成员名片时的
+// group_member_card.get_group_member_card_info, 其回包包含名片、等级、头衔及最后发言时间
+func (c *QQClient) GetGroupMemberInfo(groupCode, uin int64) (*GroupMemberInfo, error) {
+	i, err := c.sendAndWait(c.buildGroupMemberInfoRequestPacket(groupCode, uin))
+	if err != nil {
+		return nil, err
+	}
+	info := i.(*GroupMemberInfo)
+	g := c.FindGroup(groupCode)
+	if g == nil {
+		return info, nil
+	}
+	info.Group = g
+	g.memLock.Lock()
+	defer g.memLock.Unlock()
+	for _, m := range g.Members {
+		if m.Uin == uin {
+			m.Nickname = info.Nickname
+			m.CardName = info.CardName
+			m.Level = info.Level
+			m.JoinTime = info.JoinTime
+			m.LastSpeakTime = info.LastSpeakTime
+			m.SpecialTitle = info.SpecialTitle
+			m.SpecialTitleExpireTime = info.SpecialTitleExpireTime
+			m.Permission = info.Permission
+			return m, nil
+		}
+	}
+	return info, nil
+}
+
 func (c *QQClient) GetGroupMembers(group *GroupInfo) ([]*GroupMemberInfo, error) {
 	var nextUin int64
 	var list []*GroupMemberInfo
@@ -584,6 +1007,11 @@ func (c *QQClient) groupMute(groupCode, memberUin int64, time uint32) {
 	_, _ = c.sendAndWait(c.buildGroupMutePacket(groupCode, memberUin, time))
 }
 
+func (c *QQClient) setGroupAdmin(groupCode, memberUin int64, flag bool) error {
+	_, err := c.sendAndWait(c.buildGroupAdminSetPacket(groupCode, memberUin, flag))
+	return err
+}
+
 func (c *QQClient) quitGroup(groupCode int64) {
 	_, _ = c.sendAndWait(c.buildQuitGroupPacket(groupCode))
 }
@@ -593,9 +1021,6 @@ func (c *QQClient) kickGroupMember(groupCode, memberUin int64, msg string) {
 }
 
 func (g *GroupInfo) removeMember(uin int64) {
-	if g.memLock == nil {
-		g.memLock = new(sync.Mutex)
-	}
 	g.memLock.Lock()
 	defer g.memLock.Unlock()
 	for i, m := range g.Members {
@@ -702,7 +1127,9 @@ func (c *QQClient) loop() {
 				break
 			}
 			reader = binary.NewNetworkReader(c.Conn)
+			snapshot := c.snapshotGroupSeq()
 			c.registerClient()
+			go c.catchUpGroupMessages(snapshot)
 		}
 		if l <= 0 {
 			retry++
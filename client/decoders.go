@@ -1,18 +1,25 @@
 package client
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/binary/jce"
 	"github.com/Mrs4s/MiraiGo/client/pb"
+	"github.com/Mrs4s/MiraiGo/client/pb/cmd0x346"
 	"github.com/Mrs4s/MiraiGo/client/pb/cmd0x352"
 	"github.com/Mrs4s/MiraiGo/client/pb/longmsg"
 	"github.com/Mrs4s/MiraiGo/client/pb/msg"
 	"github.com/Mrs4s/MiraiGo/client/pb/multimsg"
+	"github.com/Mrs4s/MiraiGo/client/pb/oidb"
+	"github.com/Mrs4s/MiraiGo/client/pb/pttcenter"
 	"github.com/Mrs4s/MiraiGo/client/pb/structmsg"
+	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/utils"
 	"github.com/golang/protobuf/proto"
+	"regexp"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,6 +28,8 @@ import (
 var (
 	groupJoinLock  = new(sync.Mutex)
 	groupLeaveLock = new(sync.Mutex)
+
+	grayTipCmdRegex = regexp.MustCompile(`<(\{.*?\})>`)
 )
 
 func decodeLoginResponse(c *QQClient, _ uint16, payload []byte) (interface{}, error) {
@@ -129,6 +138,18 @@ func decodePushReqPacket(c *QQClient, _ uint16, payload []byte) (interface{}, er
 	return nil, c.send(pkt)
 }
 
+// MessageSvc.PbSendMsg
+func decodeMsgSendResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := msg.SendMessageResponse{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.Result != 0 {
+		return nil, fmt.Errorf("send message failed: %d %s", rsp.Result, rsp.ErrMsg)
+	}
+	return nil, nil
+}
+
 func decodeMessageSvcPacket(c *QQClient, _ uint16, payload []byte) (interface{}, error) {
 	rsp := msg.GetMessageResponse{}
 	err := proto.Unmarshal(payload, &rsp)
@@ -156,7 +177,7 @@ func decodeMessageSvcPacket(c *QQClient, _ uint16, payload []byte) (interface{},
 				MsgUid:  message.Head.MsgUid,
 			}
 			delItems = append(delItems, delItem)
-			if message.Head.ToUin != c.Uin {
+			if message.Head.ToUin != c.Uin && (message.Head.FromUin != c.Uin || message.Head.MsgType != 166) { // 只处理登录号其他设备发出的好友消息
 				continue
 			}
 			switch message.Head.MsgType {
@@ -225,12 +246,25 @@ func decodeMessageSvcPacket(c *QQClient, _ uint16, payload []byte) (interface{},
 						}
 					}
 				}
-				if message.Body.RichText == nil || message.Body.RichText.Elems == nil {
+				if message.Body.RichText == nil || (message.Body.RichText.Elems == nil && message.Body.RichText.NotOnlineFile == nil) {
+					continue
+				}
+				if message.Head.FromUin == c.Uin {
+					r := int32(message.Head.MsgUid)
+					if message.Body.RichText.Attr != nil {
+						r = message.Body.RichText.Attr.Random
+					}
+					if !c.isSentByClient(r) && c.markC2CMessage(message.Head.ToUin, message.Head.MsgSeq) {
+						c.dispatchSelfMessage(&SelfMessageEvent{PrivateMessage: c.parsePrivateMessage(message)})
+					}
 					continue
 				}
 				friend := c.FindFriend(message.Head.FromUin)
 				if friend == nil {
-					return nil, nil
+					if c.markC2CMessage(message.Head.FromUin, message.Head.MsgSeq) {
+						c.dispatchStrangerMessage(c.parsePrivateMessage(message))
+					}
+					continue
 				}
 				if friend.msgSeqList == nil {
 					friend.msgSeqList = utils.NewTTList(60)
@@ -260,7 +294,31 @@ func decodeGroupMessagePacket(c *QQClient, _ uint16, payload []byte) (interface{
 	if err != nil {
 		return nil, err
 	}
+	dispatch := c.dispatchLiveGroupMessage
 	if pkt.Message.Head.FromUin == c.Uin {
+		c.dispatchGroupMessageReceiptEvent(&groupMessageReceiptEvent{
+			Rand: pkt.Message.Body.RichText.Attr.Random,
+			Seq:  pkt.Message.Head.MsgSeq,
+		})
+		if c.isSentByClient(pkt.Message.Body.RichText.Attr.Random) {
+			c.markGroupMessage(pkt.Message.Head.GroupInfo.GroupCode, pkt.Message.Head.MsgSeq)
+			return nil, nil
+		}
+		dispatch = func(m *message.GroupMessage) {
+			c.dispatchSelfMessage(&SelfMessageEvent{GroupMessage: m})
+		}
+	}
+	deliver := func(pm *msg.Message) {
+		m := c.parseGroupMessage(pm)
+		if m == nil || !c.markGroupMessage(m.GroupCode, m.Id) { // 已由补拉分发
+			return
+		}
+		if head := pm.Head; head.FromUin != 80000000 && head.GroupInfo.GroupCardType != 2 { // 2 为富文本名片, 不处理
+			c.updateMemberCard(head.GroupInfo.GroupCode, head.FromUin, head.GroupInfo.GroupCard)
+		}
+		dispatch(m)
+	}
+	if pkt.Message.Head.FromUin == 80000000 && c.isSentByClient(pkt.Message.Body.RichText.Attr.Random) { // 本客户端发送的匿名消息, 作为回执处理
 		c.dispatchGroupMessageReceiptEvent(&groupMessageReceiptEvent{
 			Rand: pkt.Message.Body.RichText.Attr.Random,
 			Seq:  pkt.Message.Head.MsgSeq,
@@ -282,11 +340,11 @@ func decodeGroupMessagePacket(c *QQClient, _ uint16, payload []byte) (interface{
 		builder.MessageSlices = append(builder.MessageSlices, pkt.Message)
 		if int32(len(builder.MessageSlices)) >= builder.MessageCount {
 			c.groupMsgBuilders.Delete(pkt.Message.Content.DivSeq)
-			c.dispatchGroupMessage(c.parseGroupMessage(builder.build()))
+			deliver(builder.build())
 		}
 		return nil, nil
 	}
-	c.dispatchGroupMessage(c.parseGroupMessage(pkt.Message))
+	deliver(pkt.Message)
 	return nil, nil
 }
 
@@ -481,20 +539,44 @@ func decodeOnlinePushReqPacket(c *QQClient, seq uint16, payload []byte) (interfa
 					TargetUin:   target,
 					Time:        t,
 				})
-			case 0x11: // 撤回消息
+			case 0x10, 0x11, 0x14, 0x15: // 群通知: 撤回/戳一戳/群荣誉/头衔/红包运气王/精华消息
 				r.ReadByte()
 				b := pb.NotifyMsgBody{}
 				_ = proto.Unmarshal(r.ReadAvailable(), &b)
-				if b.OptMsgRecall == nil {
-					continue
+				if b.OptMsgRecall != nil {
+					for _, rm := range b.OptMsgRecall.RecalledMsgList {
+						c.dispatchGroupMessageRecalledEvent(&GroupMessageRecalledEvent{
+							GroupCode:   groupId,
+							OperatorUin: b.OptMsgRecall.Uin,
+							AuthorUin:   rm.AuthorUin,
+							MessageId:   rm.Seq,
+							Time:        rm.Time,
+						})
+					}
+				}
+				if b.OptGeneralGrayTip != nil {
+					c.grayTipProcessor(groupId, b.OptGeneralGrayTip)
 				}
-				for _, rm := range b.OptMsgRecall.RecalledMsgList {
-					c.dispatchGroupMessageRecalledEvent(&GroupMessageRecalledEvent{
-						GroupCode:   groupId,
-						OperatorUin: b.OptMsgRecall.Uin,
-						AuthorUin:   rm.AuthorUin,
-						MessageId:   rm.Seq,
-						Time:        rm.Time,
+				if b.OptMsgGrayTips != nil {
+					c.msgGrayTipProcessor(groupId, b.OptMsgGrayTips)
+				}
+				if d := b.QqGroupDigestMsg; d != nil && d.OpType == 1 {
+					c.dispatchGroupEssenceAddedEvent(&GroupEssenceAddedEvent{
+						GroupCode:    groupId,
+						MessageId:    d.Seq,
+						InternalId:   d.Random,
+						SenderUin:    d.Sender,
+						SenderNick:   string(d.SenderNick),
+						OperatorUin:  d.DigestOper,
+						OperatorNick: string(d.OperNick),
+						Time:         d.OpTime,
+					})
+				}
+				if b.OptMsgRedTips != nil && b.OptMsgRedTips.LuckyFlag == 1 {
+					c.dispatchGroupLuckyKingEvent(&GroupLuckyKingEvent{
+						GroupCode: groupId,
+						Sender:    b.OptMsgRedTips.SenderUin,
+						LuckyKing: b.OptMsgRedTips.LuckyUin,
 					})
 				}
 			}
@@ -518,6 +600,35 @@ func decodeOnlinePushReqPacket(c *QQClient, seq uint16, payload []byte) (interfa
 						})
 					}
 				}
+			case 0x122: // 好友戳一戳
+				t := pb.GeneralGrayTipInfo{}
+				if err := proto.Unmarshal(probuf, &t); err != nil {
+					return nil, err
+				}
+				sender, receiver := parsePokeTip(&t)
+				if sender == 0 {
+					continue
+				}
+				c.dispatchFriendPokeEvent(&FriendPokeEvent{
+					Sender:   sender,
+					Receiver: receiver,
+				})
+			case 0x27: // 资料变更
+				s27 := pb.Sub27{}
+				if err := proto.Unmarshal(probuf, &s27); err != nil {
+					return nil, err
+				}
+				for _, mod := range s27.ModInfos {
+					p := mod.MsgModGroupMemberProfile
+					if p == nil {
+						continue
+					}
+					for _, info := range p.MsgGroupMemberProfileInfos {
+						if info.Field == 1 { // 群名片
+							c.updateMemberCard(p.GroupCode, p.Uin, string(info.Value))
+						}
+					}
+				}
 			case 0xD4:
 				d4 := pb.SubD4{}
 				if err := proto.Unmarshal(probuf, &d4); err != nil {
@@ -750,3 +861,384 @@ func decodeMultiApplyDownResponse(c *QQClient, _ uint16, payload []byte) (interf
 	}
 	return &mt, nil
 }
+
+func decodeGroupShortVideoUploadResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := pttcenter.ShortVideoRspBody{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.PttShortVideoUploadRsp == nil {
+		return nil, errors.New("rsp is empty")
+	}
+	if rsp.PttShortVideoUploadRsp.RetCode != 0 {
+		return nil, errors.New(rsp.PttShortVideoUploadRsp.RetMsg)
+	}
+	return rsp.PttShortVideoUploadRsp, nil
+}
+
+func decodePttShortVideoDownResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := pttcenter.ShortVideoRspBody{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.PttShortVideoDownloadRsp == nil || rsp.PttShortVideoDownloadRsp.DownloadAddr == nil || len(rsp.PttShortVideoDownloadRsp.DownloadAddr.Host) == 0 {
+		return nil, errors.New("rsp is empty")
+	}
+	return rsp.PttShortVideoDownloadRsp.DownloadAddr.Host[0] + rsp.PttShortVideoDownloadRsp.DownloadAddr.UrlArgs, nil
+}
+
+func decodeAnonymousInfoResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := pb.AnonymousNickRspBody{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.AnonyRsp == nil {
+		return nil, errors.New("rsp is empty")
+	}
+	if rsp.AnonyRsp.Ret != 0 {
+		return nil, errors.New(string(rsp.AnonyRsp.ErrMsg))
+	}
+	info := message.NewAnonymousInfo(rsp.AnonyRsp.AnonyId, string(rsp.AnonyRsp.AnonyNick))
+	info.HeadPortrait = rsp.AnonyRsp.PortraitIndex
+	info.BubbleId = rsp.AnonyRsp.BubbleIndex
+	info.ExpireTime = rsp.AnonyRsp.ExpiredTime
+	info.RankColor = string(rsp.AnonyRsp.Color)
+	return info, nil
+}
+
+func parsePokeTip(t *pb.GeneralGrayTipInfo) (sender, receiver int64) {
+	for _, p := range t.MsgTemplParam {
+		switch p.Name {
+		case "uin_str1":
+			sender, _ = strconv.ParseInt(p.Value, 10, 64)
+		case "uin_str2":
+			receiver, _ = strconv.ParseInt(p.Value, 10, 64)
+		}
+	}
+	return
+}
+
+// grayTipProcessor 处理群内灰条提示
+func (c *QQClient) grayTipProcessor(groupCode int64, t *pb.GeneralGrayTipInfo) {
+	if t.BusiType == 12 && t.BusiId == 1061 { // 戳一戳
+		sender, receiver := parsePokeTip(t)
+		if sender == 0 {
+			return
+		}
+		c.dispatchGroupPokeEvent(&GroupPokeEvent{
+			GroupCode: groupCode,
+			Sender:    sender,
+			Receiver:  receiver,
+		})
+		return
+	}
+	var honor HonorType
+	switch t.TemplId {
+	case 1052:
+		honor = Performer
+	case 1053, 1833:
+		honor = Talkative
+	case 1054:
+		honor = Legend
+	case 1067:
+		honor = Emotion
+	case 1068:
+		honor = StrongNewbie
+	default:
+		return
+	}
+	e := &GroupHonorChangedEvent{
+		GroupCode: groupCode,
+		Honor:     honor,
+	}
+	for _, p := range t.MsgTemplParam {
+		switch p.Name {
+		case "uin":
+			e.Uin, _ = strconv.ParseInt(p.Value, 10, 64)
+		case "nick":
+			e.Nick = p.Value
+		}
+	}
+	if e.Uin == 0 {
+		return
+	}
+	c.dispatchGroupHonorChangedEvent(e)
+}
+
+// msgGrayTipProcessor 处理带富文本的灰条提示, 目前仅关心头衔变更:
+// <{"cmd":5,"data":"uin","text":"nick"}>获得群主授予的<{"cmd":1,"data":"url","text":"title"}>头衔
+// AIOGrayTipsInfo 不带模板或业务 id, 因此按内容结构识别: 依次只有一个成员(cmd 5)和一个头衔链接(cmd 1)
+func (c *QQClient) msgGrayTipProcessor(groupCode int64, t *pb.AIOGrayTipsInfo) {
+	var cmds []int
+	var uin int64
+	var title string
+	for _, sub := range grayTipCmdRegex.FindAllStringSubmatch(string(t.Content), -1) {
+		cmd := struct {
+			Cmd  int    `json:"cmd"`
+			Data string `json:"data"`
+			Text string `json:"text"`
+		}{}
+		if json.Unmarshal([]byte(sub[1]), &cmd) != nil {
+			return
+		}
+		cmds = append(cmds, cmd.Cmd)
+		switch cmd.Cmd {
+		case 5:
+			uin, _ = strconv.ParseInt(cmd.Data, 10, 64)
+		case 1:
+			title = cmd.Text
+		}
+	}
+	if len(cmds) != 2 || cmds[0] != 5 || cmds[1] != 1 {
+		return
+	}
+	g := c.FindGroup(groupCode)
+	if g == nil || uin == 0 {
+		return
+	}
+	if mem := g.FindMember(uin); mem != nil && mem.SpecialTitle != title {
+		old := mem.SpecialTitle
+		mem.SpecialTitle = title
+		c.dispatchMemberSpecialTitleUpdatedEvent(&MemberSpecialTitleUpdatedEvent{
+			Group:    g,
+			OldTitle: old,
+			Member:   mem,
+		})
+	}
+}
+
+func decodeGetGroupMsgResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := msg.GetGroupMsgResp{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.Result != 0 {
+		return nil, errors.New(rsp.Errmsg)
+	}
+	return &rsp, nil
+}
+
+// decodeOIDBResultResponse 只关心执行结果的 oidb 响应
+func decodeOIDBResultResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	return nil, oidbResultError(&pkg)
+}
+
+// oidbResultError 将 oidb 外层的失败结果转换为 error
+func oidbResultError(pkg *oidb.OIDBSSOPkg) error {
+	if pkg.Result == 0 {
+		return nil
+	}
+	if pkg.ErrorMsg != "" {
+		return errors.New(pkg.ErrorMsg)
+	}
+	return errors.New("oidb result " + strconv.FormatInt(int64(pkg.Result), 10))
+}
+
+// fileRetError 群文件/离线文件子响应的失败结果, wording 为空时使用 msg
+func fileRetError(code int32, msg, wording string) error {
+	if code == 0 {
+		return nil
+	}
+	if wording == "" {
+		wording = msg
+	}
+	return errors.New(wording)
+}
+
+// OidbSvc.0x6d6_*
+func decodeOIDB6d6Response(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.D6D6RspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := oidbResultError(&pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	var err error
+	switch {
+	case rsp.UploadFileRsp != nil:
+		err = fileRetError(rsp.UploadFileRsp.RetCode, rsp.UploadFileRsp.RetMsg, rsp.UploadFileRsp.ClientWording)
+	case rsp.DownloadFileRsp != nil:
+		err = fileRetError(rsp.DownloadFileRsp.RetCode, rsp.DownloadFileRsp.RetMsg, rsp.DownloadFileRsp.ClientWording)
+	case rsp.DeleteFileRsp != nil:
+		err = fileRetError(rsp.DeleteFileRsp.RetCode, rsp.DeleteFileRsp.RetMsg, rsp.DeleteFileRsp.ClientWording)
+	case rsp.MoveFileRsp != nil:
+		err = fileRetError(rsp.MoveFileRsp.RetCode, rsp.MoveFileRsp.RetMsg, rsp.MoveFileRsp.ClientWording)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
+// OidbSvc.0x6d7_*
+func decodeOIDB6d7Response(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.D6D7RspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := oidbResultError(&pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	for _, r := range []*oidb.D6D7FolderRspBody{rsp.CreateFolderRsp, rsp.DeleteFolderRsp, rsp.RenameFolderRsp} {
+		if r != nil {
+			if err := fileRetError(r.RetCode, r.RetMsg, r.ClientWording); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nil, nil
+}
+
+// OidbSvc.0x6d8_1
+func decodeOIDB6d8Response(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.D6D8RspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := oidbResultError(&pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	var err error
+	switch {
+	case rsp.FileListInfoRsp != nil:
+		err = fileRetError(rsp.FileListInfoRsp.RetCode, rsp.FileListInfoRsp.RetMsg, rsp.FileListInfoRsp.ClientWording)
+	case rsp.GroupFileCntRsp != nil:
+		err = fileRetError(rsp.GroupFileCntRsp.RetCode, rsp.GroupFileCntRsp.RetMsg, rsp.GroupFileCntRsp.ClientWording)
+	case rsp.GroupSpaceRsp != nil:
+		err = fileRetError(rsp.GroupSpaceRsp.RetCode, rsp.GroupSpaceRsp.RetMsg, rsp.GroupSpaceRsp.ClientWording)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
+// OidbSvc.0x6d9_4
+func decodeOIDB6d9Response(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.D6D9RspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := oidbResultError(&pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	if r := rsp.FeedsInfoRsp; r != nil {
+		if err := fileRetError(r.RetCode, r.RetMsg, r.ClientWording); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// OfflineFilleHandleSvr.pb_ftn_CMD_REQ_APPLY_*
+func decodeOfflineFileResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := cmd0x346.C346RspBody{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if r := rsp.ApplyDownloadRsp; r != nil {
+		if err := fileRetError(r.RetCode, r.RetMsg, ""); err != nil {
+			return nil, err
+		}
+	}
+	if r := rsp.ApplyUploadRspV3; r != nil {
+		if err := fileRetError(r.RetCode, r.RetMsg, ""); err != nil {
+			return nil, err
+		}
+	}
+	return &rsp, nil
+}
+
+// OidbSvc.0x88d_0
+func decodeGroupInfoResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.D88DRspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	if len(rsp.RspGroupInfo) == 0 {
+		return nil, errors.New(string(rsp.StrErrorInfo))
+	}
+	info := rsp.RspGroupInfo[0]
+	if info.GetResult() != 0 || info.GroupInfo == nil {
+		return nil, errors.New("group info result " + strconv.FormatInt(int64(info.GetResult()), 10))
+	}
+	return info.GroupInfo, nil
+}
+
+// OidbSvc.0xeac_1 / OidbSvc.0xeac_2
+func decodeEssenceMsgResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	pkg := oidb.OIDBSSOPkg{}
+	rsp := oidb.EACRspBody{}
+	if err := proto.Unmarshal(payload, &pkg); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(pkg.Bodybuffer, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.GetErrorCode() != 0 || pkg.Result != 0 {
+		if rsp.GetWording() != "" {
+			return nil, errors.New(rsp.GetWording())
+		}
+		return nil, errors.New("essence msg result " + strconv.FormatInt(int64(pkg.Result), 10))
+	}
+	return nil, nil
+}
+
+// group_member_card.get_group_member_card_info
+func decodeGroupMemberInfoResponse(_ *QQClient, _ uint16, payload []byte) (interface{}, error) {
+	rsp := pb.GroupMemberRspBody{}
+	if err := proto.Unmarshal(payload, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.MemInfo == nil {
+		return nil, errors.New("member not found")
+	}
+	if rsp.MemInfo.Result != 0 {
+		return nil, errors.New(string(rsp.MemInfo.Errmsg))
+	}
+	return &GroupMemberInfo{
+		Uin:                    rsp.MemInfo.Uin,
+		Nickname:               string(rsp.MemInfo.Nick),
+		CardName:               string(rsp.MemInfo.Card),
+		Level:                  uint16(rsp.MemInfo.Level),
+		JoinTime:               rsp.MemInfo.Join,
+		LastSpeakTime:          rsp.MemInfo.LastSpeak,
+		SpecialTitle:           string(rsp.MemInfo.SpecialTitle),
+		SpecialTitleExpireTime: int64(rsp.MemInfo.SpecialTitleExpireTime),
+		Permission: func() MemberPermission {
+			switch rsp.MemInfo.Role {
+			case 3:
+				return Owner
+			case 2:
+				return Administrator
+			default:
+				return Member
+			}
+		}(),
+	}, nil
+}
@@ -2,13 +2,19 @@ package client
 
 import (
 	"errors"
+	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/utils"
 	"strings"
 	"sync"
 )
 
 var (
-	ErrAlreadyOnline = errors.New("already online")
+	ErrAlreadyOnline    = errors.New("already online")
+	ErrNotFriend        = errors.New("not a friend")
+	ErrAlreadyMember    = errors.New("already a group member")
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrSettingsNotFetched 修改群设置前需要先调用 GetGroupSettings
+	ErrSettingsNotFetched = errors.New("group settings must be fetched before update")
 )
 
 type (
@@ -16,6 +22,8 @@ type (
 
 	MemberPermission int
 
+	HonorType int
+
 	LoginResponse struct {
 		Success bool
 		Error   LoginError
@@ -56,7 +64,7 @@ type (
 		Members        []*GroupMemberInfo
 
 		client  *QQClient
-		memLock *sync.Mutex
+		memLock sync.Mutex // 零值可用, 用户自行构造的 GroupInfo 也无需初始化
 	}
 
 	GroupMemberInfo struct {
@@ -87,6 +95,49 @@ type (
 		Time        int32
 	}
 
+	// SelfMessageEvent GroupMessage 与 PrivateMessage 仅有一个不为 nil
+	SelfMessageEvent struct {
+		GroupMessage   *message.GroupMessage
+		PrivateMessage *message.PrivateMessage
+	}
+
+	GroupAnnouncement struct {
+		Id              string
+		GroupCode       int64
+		Sender          int64
+		Content         string
+		Images          []string
+		Time            int64
+		Pinned          bool
+		ConfirmRequired bool
+		ShowOnJoin      bool
+	}
+
+	AnnouncementOption struct {
+		Image           []byte
+		Pinned          bool
+		ConfirmRequired bool // 需要群成员确认
+		ShowOnJoin      bool // 新成员入群时展示
+	}
+
+	GroupAnnouncementEvent struct {
+		GroupCode int64
+		Sender    int64
+		Id        string
+		Content   string
+	}
+
+	GroupPokeEvent struct {
+		GroupCode int64
+		Sender    int64
+		Receiver  int64
+	}
+
+	FriendPokeEvent struct {
+		Sender   int64
+		Receiver int64
+	}
+
 	FriendMessageRecalledEvent struct {
 		FriendUin int64
 		MessageId int32
@@ -116,6 +167,43 @@ type (
 		NewPermission MemberPermission
 	}
 
+	MemberCardUpdatedEvent struct {
+		Group   *GroupInfo
+		OldCard string
+		Member  *GroupMemberInfo
+	}
+
+	MemberSpecialTitleUpdatedEvent struct {
+		Group    *GroupInfo
+		OldTitle string
+		Member   *GroupMemberInfo
+	}
+
+	// GroupHonorChangedEvent Uin 为获得荣誉的成员, 可能不在成员缓存中
+	GroupHonorChangedEvent struct {
+		GroupCode int64
+		Honor     HonorType
+		Uin       int64
+		Nick      string
+	}
+
+	GroupLuckyKingEvent struct {
+		GroupCode int64
+		Sender    int64
+		LuckyKing int64
+	}
+
+	GroupEssenceAddedEvent struct {
+		GroupCode    int64
+		MessageId    int32
+		InternalId   int32
+		SenderUin    int64
+		SenderNick   string
+		OperatorUin  int64
+		OperatorNick string
+		Time         int64
+	}
+
 	ClientDisconnectedEvent struct {
 		Message string
 	}
@@ -184,6 +272,14 @@ const (
 	Member
 )
 
+const (
+	Talkative    HonorType = iota + 1 // 龙王
+	Performer                         // 群聊之火
+	Legend                            // 群聊炽焰
+	StrongNewbie                      // 冒尖小春笋
+	Emotion                           // 快乐源泉
+)
+
 func (g *GroupInfo) UpdateName(newName string) {
 	if g.AdministratorOrOwner() && newName != "" && strings.Count(newName, "") <= 20 {
 		g.client.updateGroupName(g.Code, newName)
@@ -197,6 +293,54 @@ func (g *GroupInfo) MuteAll(mute bool) {
 	}
 }
 
+// InviteMember 邀请好友入群, 需要管理员权限或群允许成员邀请, 否则返回 ErrPermissionDenied
+func (g *GroupInfo) InviteMember(uin int64) error {
+	if err := g.checkInvitePermission(); err != nil {
+		return err
+	}
+	return g.inviteMember(uin)
+}
+
+// InviteMembers 批量邀请好友入群, 返回每个 uin 的邀请结果, 成功时为 nil
+func (g *GroupInfo) InviteMembers(uins []int64) map[int64]error {
+	ret := make(map[int64]error, len(uins))
+	err := g.checkInvitePermission()
+	for _, uin := range uins {
+		if err != nil {
+			ret[uin] = err
+			continue
+		}
+		ret[uin] = g.inviteMember(uin)
+	}
+	return ret
+}
+
+// checkInvitePermission 非管理员时通过 0x88d 查询群是否允许成员邀请
+func (g *GroupInfo) checkInvitePermission() error {
+	if g.AdministratorOrOwner() {
+		return nil
+	}
+	s, err := g.client.GetGroupSettings(g.Code)
+	if err != nil {
+		return err
+	}
+	if !s.AllowMemberInvite {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (g *GroupInfo) inviteMember(uin int64) error {
+	if g.client.FindFriend(uin) == nil {
+		return ErrNotFriend
+	}
+	if g.FindMember(uin) != nil {
+		return ErrAlreadyMember
+	}
+	_, err := g.client.sendAndWait(g.client.buildGroupInvitePacket(g.Code, uin))
+	return err
+}
+
 func (g *GroupInfo) Quit() {
 	if g.SelfPermission() != Owner {
 		g.client.quitGroup(g.Code)
@@ -239,6 +383,23 @@ func (m *GroupMemberInfo) Mute(time uint32) {
 	}
 }
 
+// SetAdmin 设置/取消管理员, 仅群主可用.
+// 转让群主在官方客户端中需要经过短信/人脸等二次身份验证, 协议层无法完成, 故不提供
+func (m *GroupMemberInfo) SetAdmin(flag bool) error {
+	if m.Group.SelfPermission() != Owner || m.Uin == m.Group.client.Uin {
+		return ErrPermissionDenied
+	}
+	if err := m.Group.client.setGroupAdmin(m.Group.Code, m.Uin, flag); err != nil {
+		return err
+	}
+	if flag {
+		m.Permission = Administrator
+	} else {
+		m.Permission = Member
+	}
+	return nil
+}
+
 func (m *GroupMemberInfo) Manageable() bool {
 	if m.Uin == m.Group.client.Uin {
 		return true
@@ -9,14 +9,24 @@ type eventHandlers struct {
 	privateMessageHandlers      []func(*QQClient, *message.PrivateMessage)
 	tempMessageHandlers         []func(*QQClient, *message.TempMessage)
 	groupMessageHandlers        []func(*QQClient, *message.GroupMessage)
+	selfMessageHandlers         []func(*QQClient, *SelfMessageEvent)
+	strangerMessageHandlers     []func(*QQClient, *message.PrivateMessage)
 	groupMuteEventHandlers      []func(*QQClient, *GroupMuteEvent)
 	groupRecalledHandlers       []func(*QQClient, *GroupMessageRecalledEvent)
 	friendRecalledHandlers      []func(*QQClient, *FriendMessageRecalledEvent)
+	groupPokeHandlers           []func(*QQClient, *GroupPokeEvent)
+	groupAnnouncementHandlers   []func(*QQClient, *GroupAnnouncementEvent)
+	friendPokeHandlers          []func(*QQClient, *FriendPokeEvent)
 	joinGroupHandlers           []func(*QQClient, *GroupInfo)
 	leaveGroupHandlers          []func(*QQClient, *GroupLeaveEvent)
 	memberJoinedHandlers        []func(*QQClient, *MemberJoinGroupEvent)
 	memberLeavedHandlers        []func(*QQClient, *MemberLeaveGroupEvent)
 	permissionChangedHandlers   []func(*QQClient, *MemberPermissionChangedEvent)
+	memberCardUpdatedHandlers   []func(*QQClient, *MemberCardUpdatedEvent)
+	memberTitleUpdatedHandlers  []func(*QQClient, *MemberSpecialTitleUpdatedEvent)
+	groupHonorChangedHandlers   []func(*QQClient, *GroupHonorChangedEvent)
+	groupLuckyKingHandlers      []func(*QQClient, *GroupLuckyKingEvent)
+	groupEssenceAddedHandlers   []func(*QQClient, *GroupEssenceAddedEvent)
 	groupInvitedHandlers        []func(*QQClient, *GroupInvitedRequest)
 	joinRequestHandlers         []func(*QQClient, *UserJoinGroupRequest)
 	friendRequestHandlers       []func(*QQClient, *NewFriendRequest)
@@ -44,6 +54,15 @@ func (c *QQClient) OnGroupMessage(f func(*QQClient, *message.GroupMessage)) {
 	c.eventHandlers.groupMessageHandlers = append(c.eventHandlers.groupMessageHandlers, f)
 }
 
+// OnSelfMessage 登录号在其他设备上发送的好友/群消息
+func (c *QQClient) OnSelfMessage(f func(*QQClient, *SelfMessageEvent)) {
+	c.eventHandlers.selfMessageHandlers = append(c.eventHandlers.selfMessageHandlers, f)
+}
+
+func (c *QQClient) OnStrangerMessage(f func(*QQClient, *message.PrivateMessage)) {
+	c.eventHandlers.strangerMessageHandlers = append(c.eventHandlers.strangerMessageHandlers, f)
+}
+
 func (c *QQClient) OnGroupMuted(f func(*QQClient, *GroupMuteEvent)) {
 	c.eventHandlers.groupMuteEventHandlers = append(c.eventHandlers.groupMuteEventHandlers, f)
 }
@@ -68,6 +87,26 @@ func (c *QQClient) OnGroupMemberPermissionChanged(f func(*QQClient, *MemberPermi
 	c.eventHandlers.permissionChangedHandlers = append(c.eventHandlers.permissionChangedHandlers, f)
 }
 
+func (c *QQClient) OnGroupMemberCardUpdated(f func(*QQClient, *MemberCardUpdatedEvent)) {
+	c.eventHandlers.memberCardUpdatedHandlers = append(c.eventHandlers.memberCardUpdatedHandlers, f)
+}
+
+func (c *QQClient) OnGroupMemberSpecialTitleUpdated(f func(*QQClient, *MemberSpecialTitleUpdatedEvent)) {
+	c.eventHandlers.memberTitleUpdatedHandlers = append(c.eventHandlers.memberTitleUpdatedHandlers, f)
+}
+
+func (c *QQClient) OnGroupHonorChanged(f func(*QQClient, *GroupHonorChangedEvent)) {
+	c.eventHandlers.groupHonorChangedHandlers = append(c.eventHandlers.groupHonorChangedHandlers, f)
+}
+
+func (c *QQClient) OnGroupLuckyKing(f func(*QQClient, *GroupLuckyKingEvent)) {
+	c.eventHandlers.groupLuckyKingHandlers = append(c.eventHandlers.groupLuckyKingHandlers, f)
+}
+
+func (c *QQClient) OnGroupEssenceAdded(f func(*QQClient, *GroupEssenceAddedEvent)) {
+	c.eventHandlers.groupEssenceAddedHandlers = append(c.eventHandlers.groupEssenceAddedHandlers, f)
+}
+
 func (c *QQClient) OnGroupMessageRecalled(f func(*QQClient, *GroupMessageRecalledEvent)) {
 	c.eventHandlers.groupRecalledHandlers = append(c.eventHandlers.groupRecalledHandlers, f)
 }
@@ -76,6 +115,18 @@ func (c *QQClient) OnFriendMessageRecalled(f func(*QQClient, *FriendMessageRecal
 	c.eventHandlers.friendRecalledHandlers = append(c.eventHandlers.friendRecalledHandlers, f)
 }
 
+func (c *QQClient) OnGroupPoked(f func(*QQClient, *GroupPokeEvent)) {
+	c.eventHandlers.groupPokeHandlers = append(c.eventHandlers.groupPokeHandlers, f)
+}
+
+func (c *QQClient) OnFriendPoked(f func(*QQClient, *FriendPokeEvent)) {
+	c.eventHandlers.friendPokeHandlers = append(c.eventHandlers.friendPokeHandlers, f)
+}
+
+func (c *QQClient) OnGroupAnnouncement(f func(*QQClient, *GroupAnnouncementEvent)) {
+	c.eventHandlers.groupAnnouncementHandlers = append(c.eventHandlers.groupAnnouncementHandlers, f)
+}
+
 func (c *QQClient) OnGroupInvited(f func(*QQClient, *GroupInvitedRequest)) {
 	c.eventHandlers.groupInvitedHandlers = append(c.eventHandlers.groupInvitedHandlers, f)
 }
@@ -128,6 +179,28 @@ func (c *QQClient) dispatchTempMessage(msg *message.TempMessage) {
 	}
 }
 
+func (c *QQClient) dispatchSelfMessage(e *SelfMessageEvent) {
+	if e == nil || (e.GroupMessage == nil && e.PrivateMessage == nil) {
+		return
+	}
+	for _, f := range c.eventHandlers.selfMessageHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchStrangerMessage(msg *message.PrivateMessage) {
+	if msg == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.strangerMessageHandlers {
+		cover(func() {
+			f(c, msg)
+		})
+	}
+}
+
 func (c *QQClient) dispatchGroupMessage(msg *message.GroupMessage) {
 	if msg == nil {
 		return
@@ -139,6 +212,23 @@ func (c *QQClient) dispatchGroupMessage(msg *message.GroupMessage) {
 	}
 }
 
+// dispatchLiveGroupMessage 分发实时推送的群消息, 调用前需已通过 markGroupMessage 去重
+func (c *QQClient) dispatchLiveGroupMessage(msg *message.GroupMessage) {
+	c.dispatchGroupMessage(msg)
+	c.dispatchGroupAnnouncementEvent(parseAnnouncementCard(msg))
+}
+
+func (c *QQClient) dispatchGroupAnnouncementEvent(e *GroupAnnouncementEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.groupAnnouncementHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
 func (c *QQClient) dispatchGroupMuteEvent(e *GroupMuteEvent) {
 	if e == nil {
 		return
@@ -150,6 +240,28 @@ func (c *QQClient) dispatchGroupMuteEvent(e *GroupMuteEvent) {
 	}
 }
 
+func (c *QQClient) dispatchGroupPokeEvent(e *GroupPokeEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.groupPokeHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchFriendPokeEvent(e *FriendPokeEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.friendPokeHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
 func (c *QQClient) dispatchGroupMessageRecalledEvent(e *GroupMessageRecalledEvent) {
 	if e == nil {
 		return
@@ -227,6 +339,61 @@ func (c *QQClient) dispatchPermissionChanged(e *MemberPermissionChangedEvent) {
 	}
 }
 
+func (c *QQClient) dispatchMemberCardUpdatedEvent(e *MemberCardUpdatedEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.memberCardUpdatedHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchMemberSpecialTitleUpdatedEvent(e *MemberSpecialTitleUpdatedEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.memberTitleUpdatedHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchGroupHonorChangedEvent(e *GroupHonorChangedEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.groupHonorChangedHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchGroupLuckyKingEvent(e *GroupLuckyKingEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.groupLuckyKingHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
+func (c *QQClient) dispatchGroupEssenceAddedEvent(e *GroupEssenceAddedEvent) {
+	if e == nil {
+		return
+	}
+	for _, f := range c.eventHandlers.groupEssenceAddedHandlers {
+		cover(func() {
+			f(c, e)
+		})
+	}
+}
+
 func (c *QQClient) dispatchGroupMessageReceiptEvent(e *groupMessageReceiptEvent) {
 	c.eventHandlers.groupMessageReceiptHandlers.Range(func(_, f interface{}) bool {
 		go f.(func(*QQClient, *groupMessageReceiptEvent))(c, e)
@@ -10,6 +10,7 @@ import (
 	"github.com/Mrs4s/MiraiGo/message"
 	"github.com/Mrs4s/MiraiGo/utils"
 	"google.golang.org/protobuf/proto"
+	"math"
 	"math/rand"
 	"sort"
 )
@@ -56,6 +57,16 @@ type DeviceInfoFile struct {
 	IMEI        string `json:"imei"`
 }
 
+type groupMessageKey struct {
+	GroupCode int64
+	Seq       int32
+}
+
+type c2cMessageKey struct {
+	Uin int64
+	Seq int32
+}
+
 type groupMessageBuilder struct {
 	MessageSeq    int32
 	MessageCount  int32
@@ -180,33 +191,74 @@ func (info *DeviceInfo) GenDeviceInfoData() []byte {
 }
 
 func (c *QQClient) parsePrivateMessage(msg *msg.Message) *message.PrivateMessage {
-	friend := c.FindFriend(msg.Head.FromUin)
-	if friend == nil {
-		return nil
+	peer := msg.Head.FromUin
+	sender := &message.Sender{
+		Uin:      msg.Head.FromUin,
+		Nickname: msg.Head.FromNick,
+	}
+	if msg.Head.FromUin == c.Uin { // 其他设备发送的消息
+		peer = msg.Head.ToUin
+		sender.Nickname = c.Nickname
+		sender.IsFriend = true
+	} else if friend := c.FindFriend(msg.Head.FromUin); friend != nil {
+		sender.Nickname = friend.Nickname
+		sender.IsFriend = true
 	}
 	ret := &message.PrivateMessage{
-		Id:     msg.Head.MsgSeq,
-		Target: c.Uin,
-		Time:   msg.Head.MsgTime,
-		Sender: &message.Sender{
-			Uin:      friend.Uin,
-			Nickname: friend.Nickname,
-		},
+		Id:       msg.Head.MsgSeq,
+		Target:   msg.Head.ToUin,
+		Time:     msg.Head.MsgTime,
+		Sender:   sender,
 		Elements: message.ParseMessageElems(msg.Body.RichText.Elems),
 	}
+	if f := message.ParseNotOnlineFile(msg.Body.RichText.NotOnlineFile); f != nil {
+		ret.Elements = append(ret.Elements, f)
+	}
 	if msg.Body.RichText.Attr != nil {
 		ret.InternalId = msg.Body.RichText.Attr.Random
 	}
+	c.resolveC2CReply(peer, ret.Elements)
+	c.c2cMessageCache.Add(ret)
+	logStoreError(c.messageStore.SavePrivateMessage(peer, ret))
 	return ret
 }
 
+// markC2CMessage 记录已分发的非好友/自身私聊消息, 已分发过时返回 false
+func (c *QQClient) markC2CMessage(uin int64, seq int32) bool {
+	key := c2cMessageKey{Uin: uin, Seq: seq}
+	return c.c2cMsgSeqList.AddIfAbsent(key, func(i interface{}) bool { return i.(c2cMessageKey) == key })
+}
+
+// updateMemberCard 更新缓存中的群名片并在变化时触发事件
+func (c *QQClient) updateMemberCard(groupCode, uin int64, card string) {
+	g := c.FindGroup(groupCode)
+	if g == nil {
+		return
+	}
+	if mem := g.FindMember(uin); mem != nil && mem.CardName != card {
+		old := mem.CardName
+		mem.CardName = card
+		c.dispatchMemberCardUpdatedEvent(&MemberCardUpdatedEvent{
+			Group:   g,
+			OldCard: old,
+			Member:  mem,
+		})
+	}
+}
+
+// isSentByClient 判断消息是否由本客户端发送
+func (c *QQClient) isSentByClient(r int32) bool {
+	return c.sentMsgRandList.Any(func(i interface{}) bool { return i.(int32) == r })
+}
+
 func (c *QQClient) parseTempMessage(msg *msg.Message) *message.TempMessage {
 	group := c.FindGroupByUin(msg.Head.C2CTmpMsgHead.GroupUin)
 	mem := group.FindMember(msg.Head.FromUin)
-	return &message.TempMessage{
+	ret := &message.TempMessage{
 		Id:        msg.Head.MsgSeq,
 		GroupCode: group.Code,
 		GroupName: group.Name,
+		Time:      msg.Head.MsgTime,
 		Sender: &message.Sender{
 			Uin:      mem.Uin,
 			Nickname: mem.Nickname,
@@ -214,6 +266,38 @@ func (c *QQClient) parseTempMessage(msg *msg.Message) *message.TempMessage {
 		},
 		Elements: message.ParseMessageElems(msg.Body.RichText.Elems),
 	}
+	if msg.Body.RichText.Attr != nil {
+		ret.InternalId = msg.Body.RichText.Attr.Random
+	}
+	c.resolveC2CReply(mem.Uin, ret.Elements)
+	c.c2cMessageCache.Add(ret)
+	logStoreError(c.messageStore.SaveTempMessage(mem.Uin, ret))
+	return ret
+}
+
+// resolveC2CReply 从本地缓存中补全私聊/临时会话回复的原消息
+func (c *QQClient) resolveC2CReply(peer int64, elems []message.IMessageElement) {
+	for _, elem := range elems {
+		reply, ok := elem.(*message.ReplyElement)
+		if !ok || len(reply.Elements) != 0 {
+			continue
+		}
+		i := c.c2cMessageCache.First(func(i interface{}) bool {
+			switch m := i.(type) {
+			case *message.PrivateMessage:
+				return m.Id == reply.ReplySeq && (m.Sender.Uin == peer || m.Target == peer)
+			case *message.TempMessage:
+				return m.Id == reply.ReplySeq && m.Sender.Uin == peer
+			}
+			return false
+		})
+		switch m := i.(type) {
+		case *message.PrivateMessage:
+			reply.InternalId, reply.Time, reply.Elements = m.InternalId, m.Time, m.Elements
+		case *message.TempMessage:
+			reply.InternalId, reply.Time, reply.Elements = m.InternalId, m.Time, m.Elements
+		}
+	}
 }
 
 func (c *QQClient) parseGroupMessage(m *msg.Message) *message.GroupMessage {
@@ -255,15 +339,125 @@ func (c *QQClient) parseGroupMessage(m *msg.Message) *message.GroupMessage {
 		Elements:  message.ParseMessageElems(m.Body.RichText.Elems),
 		//OriginalElements: m.Body.RichText.Elems,
 	}
+	if anonInfo != nil {
+		g.AnonymousInfo = message.NewAnonymousInfo(anonInfo.AnonId, string(anonInfo.AnonNick))
+		g.AnonymousInfo.HeadPortrait = anonInfo.HeadPortrait
+		g.AnonymousInfo.ExpireTime = anonInfo.ExpireTime
+		g.AnonymousInfo.BubbleId = anonInfo.BubbleId
+		g.AnonymousInfo.RankColor = string(anonInfo.RankColor)
+	}
 	if m.Body.RichText.Attr != nil {
 		g.InternalId = m.Body.RichText.Attr.Random
 	}
+	for _, elem := range g.Elements {
+		if reply, ok := elem.(*message.ReplyElement); ok {
+			reply.GroupId = group.Code
+		}
+	}
+	logStoreError(c.messageStore.SaveGroupMessage(g))
 	return g
 }
 
+// parseGroupMessages 解析漫游消息, 分片消息的缓存由 builders 跨批次保存
+func (c *QQClient) parseGroupMessages(msgs []*msg.Message, builders map[int32]*groupMessageBuilder) []*message.GroupMessage {
+	var ret []*message.GroupMessage
+	for _, m := range msgs {
+		if m.Head == nil || m.Head.GroupInfo == nil || m.Body == nil || m.Body.RichText == nil {
+			continue
+		}
+		if m.Content != nil && m.Content.PkgNum > 1 {
+			builder, ok := builders[m.Content.DivSeq]
+			if !ok {
+				builder = &groupMessageBuilder{
+					MessageSeq:   m.Content.DivSeq,
+					MessageCount: m.Content.PkgNum,
+				}
+				builders[m.Content.DivSeq] = builder
+			}
+			builder.MessageSlices = append(builder.MessageSlices, m)
+			if int32(len(builder.MessageSlices)) >= builder.MessageCount {
+				delete(builders, m.Content.DivSeq)
+				if g := c.parseGroupMessage(builder.build()); g != nil {
+					ret = append(ret, g)
+				}
+			}
+			continue
+		}
+		if g := c.parseGroupMessage(m); g != nil {
+			ret = append(ret, g)
+		}
+	}
+	return ret
+}
+
+// markGroupMessage 记录已分发的群消息并更新该群最后的消息 seq, 已分发过时返回 false
+func (c *QQClient) markGroupMessage(groupCode int64, seq int32) bool {
+	key := groupMessageKey{GroupCode: groupCode, Seq: seq}
+	c.groupSeqLock.Lock() // 补拉与实时推送在不同的 goroutine 中, 检查、记录和更新 seq 需原子完成
+	defer c.groupSeqLock.Unlock()
+	if !c.groupMsgSeqList.AddIfAbsent(key, func(i interface{}) bool { return i.(groupMessageKey) == key }) {
+		return false
+	}
+	if last, ok := c.lastGroupSeq.Load(groupCode); !ok || last.(int32) < seq {
+		c.lastGroupSeq.Store(groupCode, seq)
+	}
+	return true
+}
+
+// snapshotGroupSeq 记录各群最后的消息 seq, 需在 registerClient 之前调用,
+// 否则实时推送会先推进 lastGroupSeq, 导致断线期间的消息被跳过
+func (c *QQClient) snapshotGroupSeq() map[int64]int32 {
+	c.groupListLock.Lock()
+	defer c.groupListLock.Unlock()
+	r := make(map[int64]int32, len(c.GroupList))
+	for _, g := range c.GroupList {
+		if i, ok := c.lastGroupSeq.Load(g.Code); ok {
+			r[g.Code] = i.(int32)
+		}
+	}
+	return r
+}
+
+// catchUpGroupMessages 补拉 snapshot 之后各群的消息, 与实时推送重复的消息会被跳过.
+// 首次登录时 snapshot 为空, 不会补拉
+func (c *QQClient) catchUpGroupMessages(snapshot map[int64]int32) {
+	for code, last := range snapshot {
+		begin := int64(last) + 1
+		builders := make(map[int32]*groupMessageBuilder)
+		for n := 0; n < 50; n++ { // 每群最多补拉 1000 条
+			rsp, err := c.sendAndWait(c.buildGetGroupMsgRequest(code, begin, begin+19))
+			if err != nil {
+				break
+			}
+			r := rsp.(*msg.GetGroupMsgResp)
+			if len(r.Msg) == 0 {
+				break
+			}
+			for _, m := range c.parseGroupMessages(r.Msg, builders) {
+				if !c.markGroupMessage(m.GroupCode, m.Id) {
+					continue
+				}
+				m.CatchUp = true
+				if m.Sender.Uin == c.Uin {
+					if !c.isSentByClient(m.InternalId) {
+						c.dispatchSelfMessage(&SelfMessageEvent{GroupMessage: m})
+					}
+					continue
+				}
+				c.dispatchGroupMessage(m)
+				c.dispatchGroupAnnouncementEvent(parseAnnouncementCard(m))
+			}
+			if int64(r.ReturnEndSeq) < begin+19 {
+				break
+			}
+			begin = int64(r.ReturnEndSeq) + 1
+		}
+	}
+}
+
 func (b *groupMessageBuilder) build() *msg.Message {
 	sort.Slice(b.MessageSlices, func(i, j int) bool {
-		return b.MessageSlices[i].Content.PkgIndex < b.MessageSlices[i].Content.PkgIndex
+		return b.MessageSlices[i].Content.PkgIndex < b.MessageSlices[j].Content.PkgIndex
 	})
 	base := b.MessageSlices[0]
 	for _, m := range b.MessageSlices[1:] {
@@ -292,12 +486,57 @@ func genForwardTemplate(resId, preview, title, brief, source, summary string, ts
 	}}
 }
 
+// parseForwardMessage 解析合并转发, 嵌套的转发内容从 items 中按文件名取出
+func parseForwardMessage(msgs []*msg.Message, items map[string][]*msg.Message) *message.ForwardMessage {
+	ret := &message.ForwardMessage{}
+	for _, m := range msgs {
+		elems := message.ParseMessageElems(m.Body.RichText.Elems)
+		for i, e := range elems {
+			if fe, ok := e.(*message.ForwardElement); ok && fe.FileName != "" {
+				if nested, ok := items[fe.FileName]; ok {
+					delete(items, fe.FileName)
+					elems[i] = parseForwardMessage(nested, items)
+				}
+			}
+		}
+		ret.Nodes = append(ret.Nodes, &message.ForwardNode{
+			SenderId: m.Head.FromUin,
+			SenderName: func() string {
+				if m.Head.MsgType == 82 && m.Head.GroupInfo != nil {
+					return m.Head.GroupInfo.GroupCard
+				}
+				return m.Head.FromNick
+			}(),
+			Time:    m.Head.MsgTime,
+			Message: elems,
+		})
+	}
+	return ret
+}
+
+func forwardPreview(m *message.ForwardMessage) (pv string) {
+	for i := 0; i < int(math.Min(4, float64(len(m.Nodes)))); i++ {
+		pv += fmt.Sprintf(`<title size="26" color="#777777">%s: %s</title>`, utils.XmlEscape(m.Nodes[i].SenderName), utils.XmlEscape(message.ToReadableString(m.Nodes[i].Message)))
+	}
+	return
+}
+
+func longMessageBrief(m *message.ForwardMessage) (r string) {
+	for _, n := range m.Nodes {
+		r += message.ToReadableString(n.Message)
+		if len(r) >= 27 {
+			break
+		}
+	}
+	return
+}
+
 func genLongTemplate(resId, brief string, ts int64) *message.SendingMessage {
 	limited := func() string {
 		if len(brief) > 30 {
-			return brief[:30] + "…"
+			return utils.XmlEscape(brief[:30]) + "…"
 		}
-		return brief
+		return utils.XmlEscape(brief)
 	}()
 	template := fmt.Sprintf(`<?xml version='1.0' encoding='UTF-8' standalone='yes' ?><msg serviceID="35" templateID="1" action="viewMultiMsg" brief="%s" m_resid="%s" m_fileName="%d" sourceMsgId="0" url="" flag="3" adverSign="0" multiMsgFlag="1"> <item layout="1"> <title>%s</title> <hr hidden="false" style="0"/> <summary>点击查看完整消息</summary> </item> <source name="聊天记录" icon="" action="" appid="-1"/> </msg>`,
 		limited, resId, ts, limited,
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Mrs4s/MiraiGo/message"
+	"github.com/Mrs4s/MiraiGo/utils"
+	"strconv"
+)
+
+type (
+	EssenceMessage struct {
+		GroupCode    int64
+		MessageId    int32
+		InternalId   int32
+		SenderUin    int64
+		SenderNick   string
+		SenderTime   int64
+		OperatorUin  int64
+		OperatorNick string
+		OperatorTime int64
+		Elements     []message.IMessageElement
+	}
+
+	essenceMsgItem struct {
+		MsgSeq        int32  `json:"msg_seq"`
+		MsgRandom     int64  `json:"msg_random"` // 可能以无符号数返回
+		SenderUin     string `json:"sender_uin"`
+		SenderNick    string `json:"sender_nick"`
+		SenderTime    int64  `json:"sender_time"`
+		AddDigestUin  string `json:"add_digest_uin"`
+		AddDigestNick string `json:"add_digest_nick"`
+		AddDigestTime int64  `json:"add_digest_time"`
+		MsgContent    []struct {
+			MsgType   int32  `json:"msg_type"`
+			Text      string `json:"text"`
+			FaceIndex int32  `json:"face_index"`
+			ImageUrl  string `json:"image_url"`
+		} `json:"msg_content"`
+	}
+)
+
+// SetEssenceMessage 设为精华消息, 需要管理员权限
+func (c *QQClient) SetEssenceMessage(groupCode int64, msgId, msgInternalId int32) error {
+	_, err := c.sendAndWait(c.buildEssenceMsgOperatePacket(groupCode, msgId, msgInternalId, 1))
+	return err
+}
+
+// RemoveEssenceMessage 移出精华消息, 需要管理员权限
+func (c *QQClient) RemoveEssenceMessage(groupCode int64, msgId, msgInternalId int32) error {
+	_, err := c.sendAndWait(c.buildEssenceMsgOperatePacket(groupCode, msgId, msgInternalId, 2))
+	return err
+}
+
+// ListEssenceMessages 获取群内全部精华消息
+func (c *QQClient) ListEssenceMessages(groupCode int64) ([]*EssenceMessage, error) {
+	var ret []*EssenceMessage
+	for page := 0; ; page++ {
+		b, err := utils.HttpGetBytesWithCookie(fmt.Sprintf("https://qun.qq.com/cgi-bin/group_digest/digest_list?bkn=%d&group_code=%d&page_start=%d&page_limit=20", c.getCSRFToken(), groupCode, page), c.getCookies())
+		if err != nil {
+			return nil, err
+		}
+		rsp := struct {
+			RetCode int    `json:"retcode"`
+			RetMsg  string `json:"retmsg"`
+			Data    struct {
+				MsgList []*essenceMsgItem `json:"msg_list"`
+				IsEnd   bool              `json:"is_end"`
+			} `json:"data"`
+		}{}
+		if err = json.Unmarshal(b, &rsp); err != nil {
+			return nil, err
+		}
+		if rsp.RetCode != 0 {
+			return nil, errors.New(rsp.RetMsg)
+		}
+		for _, item := range rsp.Data.MsgList {
+			ret = append(ret, item.toEssenceMessage(groupCode))
+		}
+		if rsp.Data.IsEnd || len(rsp.Data.MsgList) == 0 {
+			break
+		}
+	}
+	return ret, nil
+}
+
+func (item *essenceMsgItem) toEssenceMessage(groupCode int64) *EssenceMessage {
+	m := &EssenceMessage{
+		GroupCode:    groupCode,
+		MessageId:    item.MsgSeq,
+		InternalId:   int32(item.MsgRandom),
+		SenderNick:   item.SenderNick,
+		SenderTime:   item.SenderTime,
+		OperatorNick: item.AddDigestNick,
+		OperatorTime: item.AddDigestTime,
+	}
+	m.SenderUin, _ = strconv.ParseInt(item.SenderUin, 10, 64)
+	m.OperatorUin, _ = strconv.ParseInt(item.AddDigestUin, 10, 64)
+	for _, e := range item.MsgContent {
+		switch e.MsgType {
+		case 1:
+			m.Elements = append(m.Elements, message.NewText(e.Text))
+		case 2:
+			m.Elements = append(m.Elements, message.NewFace(e.FaceIndex))
+		case 3:
+			m.Elements = append(m.Elements, &message.ImageElement{Url: e.ImageUrl})
+		default:
+			m.Elements = append(m.Elements, message.NewText("[不支持的消息]"))
+		}
+	}
+	return m
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"github.com/Mrs4s/MiraiGo/client/pb/oidb"
+	"github.com/Mrs4s/MiraiGo/message"
+	"github.com/golang/protobuf/proto"
+	"io"
+	"math/rand"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+type (
+	GroupFileSystem struct {
+		FileCount  int32
+		LimitCount int32
+		UsedSpace  int64
+		TotalSpace int64
+		GroupCode  int64
+
+		client *QQClient
+	}
+
+	GroupFile struct {
+		GroupCode      int64
+		FileId         string
+		FileName       string
+		BusId          int32
+		FileSize       int64
+		UploadTime     int64
+		DeadTime       int64
+		ModifyTime     int64
+		DownloadTimes  int64
+		Uploader       int64
+		UploaderName   string
+		ParentFolderId string
+	}
+
+	GroupFolder struct {
+		GroupCode      int64
+		FolderId       string
+		FolderName     string
+		CreateTime     int64
+		Creator        int64
+		CreatorName    string
+		TotalFileCount int32
+		ParentFolderId string
+	}
+)
+
+// GetGroupFileSystem 获取群文件系统及其容量信息
+func (c *QQClient) GetGroupFileSystem(groupCode int64) (*GroupFileSystem, error) {
+	fs := &GroupFileSystem{
+		GroupCode: groupCode,
+		client:    c,
+	}
+	i, err := c.sendAndWait(c.buildGroupFileCountRequestPacket(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	cnt := i.(*oidb.D6D8RspBody).GroupFileCntRsp
+	if cnt == nil {
+		return nil, errors.New("file count rsp is empty")
+	}
+	fs.FileCount = cnt.AllFileCount
+	fs.LimitCount = cnt.LimitCount
+	i, err = c.sendAndWait(c.buildGroupFileSpaceRequestPacket(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	space := i.(*oidb.D6D8RspBody).GroupSpaceRsp
+	if space == nil {
+		return nil, errors.New("space rsp is empty")
+	}
+	fs.UsedSpace = space.UsedSpace
+	fs.TotalSpace = space.TotalSpace
+	return fs, nil
+}
+
+// GetGroupFileUrl 获取聊天中群文件消息的下载链接
+func (c *QQClient) GetGroupFileUrl(groupCode int64, e *message.GroupFileElement) (string, error) {
+	return c.getGroupFileUrl(groupCode, e.Path, e.Name, e.Busid)
+}
+
+func (c *QQClient) getGroupFileUrl(groupCode int64, fileId, fileName string, busId int32) (string, error) {
+	i, err := c.sendAndWait(c.buildGroupFileDownloadReqPacket(groupCode, fileId, busId))
+	if err != nil {
+		return "", err
+	}
+	rsp := i.(*oidb.D6D6RspBody).DownloadFileRsp
+	if rsp == nil {
+		return "", errors.New("download rsp is empty")
+	}
+	return "http://" + rsp.DownloadIp + "/ftn_handler/" + hex.EncodeToString(rsp.DownloadUrl) + "/?fname=" + url.QueryEscape(fileName), nil
+}
+
+// Root 获取根目录下的文件与文件夹
+func (fs *GroupFileSystem) Root() ([]*GroupFile, []*GroupFolder, error) {
+	return fs.GetFilesByFolder("/")
+}
+
+func (fs *GroupFileSystem) GetFilesByFolder(folderId string) ([]*GroupFile, []*GroupFolder, error) {
+	var files []*GroupFile
+	var folders []*GroupFolder
+	var startIndex int32
+	for {
+		i, err := fs.client.sendAndWait(fs.client.buildGroupFileListRequestPacket(fs.GroupCode, folderId, startIndex))
+		if err != nil {
+			return nil, nil, err
+		}
+		rsp := i.(*oidb.D6D8RspBody).FileListInfoRsp
+		if rsp == nil {
+			break
+		}
+		for _, item := range rsp.ItemList {
+			if item.FileInfo != nil {
+				files = append(files, &GroupFile{
+					GroupCode:      fs.GroupCode,
+					FileId:         item.FileInfo.FileId,
+					FileName:       item.FileInfo.FileName,
+					BusId:          item.FileInfo.BusId,
+					FileSize:       item.FileInfo.FileSize,
+					UploadTime:     item.FileInfo.UploadTime,
+					DeadTime:       item.FileInfo.DeadTime,
+					ModifyTime:     item.FileInfo.ModifyTime,
+					DownloadTimes:  item.FileInfo.DownloadTimes,
+					Uploader:       item.FileInfo.UploaderUin,
+					UploaderName:   item.FileInfo.UploaderName,
+					ParentFolderId: item.FileInfo.ParentFolderId,
+				})
+			}
+			if item.FolderInfo != nil {
+				folders = append(folders, &GroupFolder{
+					GroupCode:      fs.GroupCode,
+					FolderId:       item.FolderInfo.FolderId,
+					FolderName:     item.FolderInfo.FolderName,
+					CreateTime:     item.FolderInfo.CreateTime,
+					Creator:        item.FolderInfo.CreateUin,
+					CreatorName:    item.FolderInfo.CreatorName,
+					TotalFileCount: item.FolderInfo.TotalFileCount,
+					ParentFolderId: item.FolderInfo.ParentFolderId,
+				})
+			}
+		}
+		if rsp.IsEnd || len(rsp.ItemList) == 0 {
+			break
+		}
+		startIndex = rsp.NextIndex
+	}
+	return files, folders, nil
+}
+
+func (fs *GroupFileSystem) GetDownloadUrl(file *GroupFile) (string, error) {
+	return fs.client.getGroupFileUrl(fs.GroupCode, file.FileId, file.FileName, file.BusId)
+}
+
+// UploadFile 上传本地文件到 folderId 目录, name 为空时使用本地文件名
+func (fs *GroupFileSystem) UploadFile(p, name, folderId string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if name == "" {
+		name = filepath.Base(p)
+	}
+	mh, sh := md5.New(), sha1.New()
+	size, err := io.Copy(io.MultiWriter(mh, sh), f)
+	if err != nil {
+		return err
+	}
+	var h [16]byte
+	copy(h[:], mh.Sum(nil))
+	c := fs.client
+	i, err := c.sendAndWait(c.buildGroupFileUploadReqPacket(fs.GroupCode, folderId, name, size, h[:], sh.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	rsp := i.(*oidb.D6D6RspBody).UploadFileRsp
+	if rsp == nil {
+		return errors.New("upload rsp is empty")
+	}
+	if !rsp.BoolFileExist {
+		ext, _ := proto.Marshal(&oidb.GroupFileUploadExt{
+			Unknown1: 100,
+			Unknown2: 1,
+			Entry: &oidb.GroupFileUploadEntry{
+				Business: &oidb.ExcitingBusiInfo{
+					BusId:       rsp.BusId,
+					SenderUin:   c.Uin,
+					ReceiverUin: fs.GroupCode,
+					GroupCode:   fs.GroupCode,
+				},
+				FileEntry: &oidb.ExcitingFileEntry{
+					FileSize:  size,
+					Md5:       h[:],
+					Sha1:      sh.Sum(nil),
+					FileId:    rsp.FileId,
+					UploadKey: rsp.CheckKey,
+				},
+				ClientInfo: &oidb.ExcitingClientInfo{
+					ClientType:   2,
+					AppId:        "5",
+					TerminalType: 2,
+					ClientVer:    "9e9c09dc",
+					Unknown:      4,
+				},
+				FileNameInfo: &oidb.ExcitingFileNameInfo{FileName: name},
+				Host: &oidb.ExcitingHostConfig{Hosts: []*oidb.ExcitingHostInfo{{
+					Url:  &oidb.ExcitingUrl{Unknown: 1, Host: rsp.UploadIp},
+					Port: rsp.UploadPort,
+				}}},
+			},
+		})
+		servers := append([]string{rsp.UploadIp}, rsp.UploadIpLanV4...)
+		for _, ip := range servers {
+			if _, err = f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			if _, err = c.highwayUploadStream(ip+":"+strconv.FormatInt(int64(rsp.UploadPort), 10), rsp.CheckKey, f, size, h, ext, 71); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err = c.sendAndWait(c.buildGroupFileFeedsRequest(fs.GroupCode, rsp.FileId, rsp.BusId, rand.Int31()))
+	return err
+}
+
+func (fs *GroupFileSystem) CreateFolder(parentFolder, name string) error {
+	_, err := fs.client.sendAndWait(fs.client.buildGroupFileCreateFolderPacket(fs.GroupCode, parentFolder, name))
+	return err
+}
+
+func (fs *GroupFileSystem) RenameFolder(folderId, newName string) error {
+	_, err := fs.client.sendAndWait(fs.client.buildGroupFileRenameFolderPacket(fs.GroupCode, folderId, newName))
+	return err
+}
+
+func (fs *GroupFileSystem) DeleteFolder(folderId string) error {
+	_, err := fs.client.sendAndWait(fs.client.buildGroupFileDeleteFolderPacket(fs.GroupCode, folderId))
+	return err
+}
+
+func (fs *GroupFileSystem) DeleteFile(file *GroupFile) error {
+	_, err := fs.client.sendAndWait(fs.client.buildGroupFileDeleteReqPacket(fs.GroupCode, file.ParentFolderId, file.FileId, file.BusId))
+	return err
+}
+
+// MoveFile 移动文件到 destFolderId 目录, 成功后更新 file.ParentFolderId
+func (fs *GroupFileSystem) MoveFile(file *GroupFile, destFolderId string) error {
+	if _, err := fs.client.sendAndWait(fs.client.buildGroupFileMoveReqPacket(fs.GroupCode, file.FileId, file.ParentFolderId, destFolderId, file.BusId)); err != nil {
+		return err
+	}
+	file.ParentFolderId = destFolderId
+	return nil
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Mrs4s/MiraiGo/client/pb/oidb"
+	"github.com/Mrs4s/MiraiGo/utils"
+)
+
+type (
+	GroupJoinPolicy int32
+
+	// GroupSettings 群设置, 修改时需先通过 GetGroupSettings 获取, 改动后再调用 UpdateGroupSettings,
+	// 只有与获取时不同的字段会被提交
+	GroupSettings struct {
+		JoinPolicy GroupJoinPolicy
+		// Question 与 Answer 仅在 JoinPolicy 需要回答问题时有效
+		Question          string
+		Answer            string
+		Memo              string
+		AnonymousChat     bool
+		AllowMemberInvite bool
+		AllowUploadFile   bool
+		// NewMemberGuidelines 无法通过 GetGroupSettings 获取, 为 nil 时不修改
+		NewMemberGuidelines *GroupGuidelines
+		// Geo 为 nil 时不修改
+		Geo *GroupGeoInfo
+
+		groupCode int64
+		origin    *GroupSettings // 获取时的快照
+	}
+
+	GroupGuidelines struct {
+		Enabled bool
+		Content string
+	}
+
+	GroupGeoInfo struct {
+		CityId    int32
+		Longitude int64
+		Latitude  int64
+		Content   string
+	}
+)
+
+const (
+	JoinAllowAnyone GroupJoinPolicy = iota + 1
+	JoinNeedApproval
+	JoinRefuseAll
+	JoinAnswerQuestion
+	JoinQuestionAndApproval
+)
+
+const (
+	groupFlagExtAnonymousDisabled    = 0x40000000 // 0x88d groupFlagExt
+	groupFlagExt3MemberInviteAllowed = 0x00000001 // 0x88d groupFlagext3
+	appPrivilegeUploadFileDisabled   = 0x00000001 // 0x88d/0x89a appPrivilegeFlag
+)
+
+// GetGroupSettings 获取群设置
+func (c *QQClient) GetGroupSettings(groupCode int64) (*GroupSettings, error) {
+	i, err := c.sendAndWait(c.buildGroupInfoRequestPacket(groupCode))
+	if err != nil {
+		return nil, err
+	}
+	info := i.(*oidb.D88DGroupInfo)
+	s := &GroupSettings{
+		JoinPolicy:        GroupJoinPolicy(info.GetGroupOption()),
+		Question:          string(info.IngGroupQuestion),
+		Answer:            string(info.IngGroupAnswer),
+		Memo:              string(info.GroupMemo),
+		AnonymousChat:     info.GetGroupFlagExt()&groupFlagExtAnonymousDisabled == 0,
+		AllowMemberInvite: info.GetGroupFlagext3()&groupFlagExt3MemberInviteAllowed != 0,
+		AllowUploadFile:   info.GetAppPrivilegeFlag()&appPrivilegeUploadFileDisabled == 0,
+	}
+	if geo := info.GroupGeoInfo; geo != nil {
+		s.Geo = &GroupGeoInfo{
+			CityId:    int32(geo.GetCityid()),
+			Longitude: geo.GetLongitude(),
+			Latitude:  geo.GetLatitude(),
+			Content:   string(geo.Geocontent),
+		}
+	}
+	origin := *s
+	if s.Geo != nil {
+		geo := *s.Geo
+		origin.Geo = &geo
+	}
+	s.groupCode, s.origin = groupCode, &origin
+	return s, nil
+}
+
+// UpdateGroupSettings 修改群设置, 需要管理员权限. s 必须来自该群的 GetGroupSettings
+func (c *QQClient) UpdateGroupSettings(groupCode int64, s *GroupSettings) error {
+	if s.origin == nil || s.groupCode != groupCode {
+		return ErrSettingsNotFetched
+	}
+	if g := c.FindGroup(groupCode); g != nil && !g.AdministratorOrOwner() {
+		return ErrPermissionDenied
+	}
+	if _, err := c.sendAndWait(c.buildGroupSettingsUpdatePacket(groupCode, s, s.origin)); err != nil {
+		return err
+	}
+	if g := c.FindGroup(groupCode); g != nil {
+		g.Memo = s.Memo
+	}
+	s.origin.Memo, s.origin.JoinPolicy, s.origin.Question, s.origin.Answer = s.Memo, s.JoinPolicy, s.Question, s.Answer
+	s.origin.AllowMemberInvite, s.origin.AllowUploadFile = s.AllowMemberInvite, s.AllowUploadFile
+	if s.Geo != nil {
+		geo := *s.Geo
+		s.origin.Geo = &geo
+	}
+	if s.AnonymousChat != s.origin.AnonymousChat {
+		if err := c.setGroupAnonymousChat(groupCode, s.AnonymousChat); err != nil {
+			return err
+		}
+		s.origin.AnonymousChat = s.AnonymousChat
+	}
+	return nil
+}
+
+func (g *GroupInfo) GetSettings() (*GroupSettings, error) {
+	return g.client.GetGroupSettings(g.Code)
+}
+
+func (g *GroupInfo) UpdateSettings(s *GroupSettings) error {
+	return g.client.UpdateGroupSettings(g.Code, s)
+}
+
+// setGroupAnonymousChat 匿名聊天开关不在 0x89a 中, 需通过 web 接口修改
+func (c *QQClient) setGroupAnonymousChat(groupCode int64, enable bool) error {
+	b, err := utils.HttpGetBytesWithCookie(fmt.Sprintf("https://qqweb.qq.com/c/anonymoustalk/set_anony_switch?bkn=%d&value=%s&group_code=%d", c.getCSRFToken(), boolToIntString(enable), groupCode), c.getCookies())
+	if err != nil {
+		return err
+	}
+	rsp := struct {
+		RetCode int    `json:"retcode"`
+		Msg     string `json:"msg"`
+	}{}
+	if err = json.Unmarshal(b, &rsp); err != nil {
+		return err
+	}
+	if rsp.RetCode != 0 {
+		return errors.New(rsp.Msg)
+	}
+	return nil
+}
@@ -1,47 +1,97 @@
 package client
 
 import (
+	"bytes"
 	"crypto/md5"
 	"errors"
+	"fmt"
 	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/client/pb"
 	"github.com/golang/protobuf/proto"
+	"io"
 	"net"
 	"time"
 )
 
+const highwayBlockSize = 8192 * 1024
+
 func (c *QQClient) highwayUploadImage(ser string, updKey, img []byte, cmdId int32) error {
+	_, err := c.highwayUpload(ser, updKey, img, EmptyBytes, cmdId)
+	return err
+}
+
+// highwayUpload 上传数据并返回服务器回包中的 RspExtendinfo
+func (c *QQClient) highwayUpload(ser string, updKey, data, ext []byte, cmdId int32) ([]byte, error) {
+	return c.highwayUploadStream(ser, updKey, bytes.NewReader(data), int64(len(data)), md5.Sum(data), ext, cmdId)
+}
+
+// highwayUploadStream 从 r 中分块读取 size 字节上传, 每个分块单独设置超时并等待服务器确认
+func (c *QQClient) highwayUploadStream(ser string, updKey []byte, r io.Reader, size int64, fmd5 [16]byte, ext []byte, cmdId int32) ([]byte, error) {
 	conn, err := net.DialTimeout("tcp", ser, time.Second*5)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Close()
-	if err = conn.SetDeadline(time.Now().Add(time.Second * 10)); err != nil {
-		return err
+	bufSize := int64(highwayBlockSize)
+	if size < bufSize {
+		bufSize = size
+	}
+	buf := make([]byte, bufSize)
+	var rspExt []byte
+	for offset := int64(0); offset < size; {
+		chunk := buf
+		if size-offset < int64(len(chunk)) {
+			chunk = chunk[:size-offset]
+		}
+		if _, err = io.ReadFull(r, chunk); err != nil {
+			return nil, err
+		}
+		if err = conn.SetDeadline(time.Now().Add(time.Second * 30)); err != nil {
+			return nil, err
+		}
+		if _, err = conn.Write(c.buildHighwayDataPacket(chunk, updKey, ext, cmdId, size, offset, fmd5)); err != nil {
+			return nil, err
+		}
+		rsp, err := readHighwayResponse(conn)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.ErrorCode != 0 {
+			return nil, fmt.Errorf("upload failed: %d", rsp.ErrorCode)
+		}
+		if len(rsp.RspExtendinfo) != 0 {
+			rspExt = rsp.RspExtendinfo
+		}
+		offset += int64(len(chunk))
+	}
+	return rspExt, nil
+}
+
+func readHighwayResponse(conn net.Conn) (*pb.RspDataHighwayHead, error) {
+	r := binary.NewNetworkReader(conn)
+	if b, err := r.ReadByte(); err != nil {
+		return nil, err
+	} else if b != 40 {
+		return nil, errors.New("invalid highway response")
 	}
-	h := md5.Sum(img)
-	pkt := c.buildImageUploadPacket(img, updKey, cmdId, h)
-	for _, p := range pkt {
-		_, err = conn.Write(p)
+	hl, err := r.ReadInt32()
+	if err != nil {
+		return nil, err
 	}
+	bl, err := r.ReadInt32()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	r := binary.NewNetworkReader(conn)
-	_, err = r.ReadByte()
+	head, err := r.ReadBytes(int(hl))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	hl, _ := r.ReadInt32()
-	_, _ = r.ReadBytes(4)
-	payload, _ := r.ReadBytes(int(hl))
-	_ = conn.Close()
-	rsp := pb.RspDataHighwayHead{}
-	if err = proto.Unmarshal(payload, &rsp); err != nil {
-		return err
+	if _, err = r.ReadBytes(int(bl) + 1); err != nil { // body 及结尾的 41
+		return nil, err
 	}
-	if rsp.ErrorCode != 0 {
-		return errors.New("upload failed")
+	rsp := pb.RspDataHighwayHead{}
+	if err = proto.Unmarshal(head, &rsp); err != nil {
+		return nil, err
 	}
-	return nil
+	return &rsp, nil
 }
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Mrs4s/MiraiGo/message"
+	"github.com/Mrs4s/MiraiGo/utils"
+	"html"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strconv"
+)
+
+func (c *QQClient) getCookies() string {
+	return fmt.Sprintf("uin=o%d; skey=%s;", c.Uin, c.sigInfo.sKey)
+}
+
+func (c *QQClient) getCSRFToken() int {
+	accu := 5381
+	for _, b := range c.sigInfo.sKey {
+		accu = accu + (accu << 5) + int(b)
+	}
+	return 2147483647 & accu
+}
+
+// MuteAnonymous 禁言匿名成员, flag 为 message.AnonymousInfo.Flag
+func (g *GroupInfo) MuteAnonymous(flag string, seconds int32) error {
+	id, nick, ok := message.ParseAnonymousFlag(flag)
+	if !ok {
+		return errors.New("invalid anonymous flag")
+	}
+	c := g.client
+	form := url.Values{}
+	form.Set("anon_id", id)
+	form.Set("anon_nick", nick)
+	form.Set("begin_from", "2")
+	form.Set("bkn", fmt.Sprint(c.getCSRFToken()))
+	form.Set("group_code", fmt.Sprint(g.Code))
+	form.Set("seconds", fmt.Sprint(seconds))
+	b, err := utils.HttpPostBytesWithCookie("https://qqweb.qq.com/c/anonymoustalk/blacklist", []byte(form.Encode()), c.getCookies(), "application/x-www-form-urlencoded")
+	if err != nil {
+		return err
+	}
+	rsp := struct {
+		RetCode int    `json:"retcode"`
+		Msg     string `json:"msg"`
+	}{}
+	if err = json.Unmarshal(b, &rsp); err != nil {
+		return err
+	}
+	if rsp.RetCode != 0 {
+		return errors.New(rsp.Msg)
+	}
+	return nil
+}
+
+const announcementPageSize = 20
+
+type announcementImage struct {
+	Id     string `json:"id"`
+	Width  string `json:"w"`
+	Height string `json:"h"`
+}
+
+type announcementFeed struct {
+	Id      string `json:"fid"`
+	Sender  int64  `json:"u"`
+	Time    int64  `json:"pubt"`
+	Type    int32  `json:"type"`
+	Pinned  int32  `json:"pinned"`
+	Message struct {
+		Text   string               `json:"text"`
+		Images []*announcementImage `json:"pics"`
+	} `json:"msg"`
+	Settings struct {
+		ConfirmRequired int32 `json:"confirm_required"`
+	} `json:"settings"`
+}
+
+// GetAnnouncements 获取群全部公告, 置顶公告在前
+func (g *GroupInfo) GetAnnouncements() ([]*GroupAnnouncement, error) {
+	c := g.client
+	var ret []*GroupAnnouncement
+	seen := make(map[string]bool)
+	start := -1
+	for {
+		b, err := utils.HttpGetBytesWithCookie(fmt.Sprintf("https://web.qun.qq.com/cgi-bin/announce/get_t_list?bkn=%d&qid=%d&ft=23&s=%d&n=%d", c.getCSRFToken(), g.Code, start, announcementPageSize), c.getCookies())
+		if err != nil {
+			return nil, err
+		}
+		rsp := struct {
+			RetCode int                 `json:"ec"`
+			Msg     string              `json:"em"`
+			Feeds   []*announcementFeed `json:"feeds"`
+			Inst    []*announcementFeed `json:"inst"`
+		}{}
+		if err = json.Unmarshal(b, &rsp); err != nil {
+			return nil, err
+		}
+		if rsp.RetCode != 0 {
+			return nil, errors.New(rsp.Msg)
+		}
+		added := 0
+		for _, f := range append(rsp.Inst, rsp.Feeds...) {
+			if seen[f.Id] {
+				continue
+			}
+			seen[f.Id] = true
+			added++
+			ret = append(ret, f.toAnnouncement(g.Code))
+		}
+		if len(rsp.Feeds) < announcementPageSize || added == 0 {
+			break
+		}
+		if start < 0 {
+			start = 0
+		}
+		start += len(rsp.Feeds)
+	}
+	return ret, nil
+}
+
+func (f *announcementFeed) toAnnouncement(groupCode int64) *GroupAnnouncement {
+	a := &GroupAnnouncement{
+		Id:              f.Id,
+		GroupCode:       groupCode,
+		Sender:          f.Sender,
+		Content:         html.UnescapeString(f.Message.Text),
+		Time:            f.Time,
+		Pinned:          f.Pinned == 1,
+		ConfirmRequired: f.Settings.ConfirmRequired == 1,
+		ShowOnJoin:      f.Type == 20,
+	}
+	for _, img := range f.Message.Images {
+		a.Images = append(a.Images, "https://gdynamic.qpic.cn/gdynamic/"+img.Id+"/628")
+	}
+	return a
+}
+
+// PublishAnnouncement 发布群公告, 返回公告 id. opt 可为 nil
+func (g *GroupInfo) PublishAnnouncement(content string, opt *AnnouncementOption) (string, error) {
+	if opt == nil {
+		opt = &AnnouncementOption{}
+	}
+	c := g.client
+	form := url.Values{}
+	form.Set("qid", fmt.Sprint(g.Code))
+	form.Set("bkn", fmt.Sprint(c.getCSRFToken()))
+	form.Set("text", content)
+	form.Set("pinned", boolToIntString(opt.Pinned))
+	if opt.ShowOnJoin {
+		form.Set("type", "20")
+	} else {
+		form.Set("type", "1")
+	}
+	form.Set("settings", fmt.Sprintf(`{"is_show_edit_card":0,"tip_window_type":1,"confirm_required":%s}`, boolToIntString(opt.ConfirmRequired)))
+	if len(opt.Image) > 0 {
+		img, err := c.uploadAnnouncementImage(opt.Image)
+		if err != nil {
+			return "", err
+		}
+		form.Set("pic", img.Id)
+		form.Set("imgWidth", img.Width)
+		form.Set("imgHeight", img.Height)
+	}
+	b, err := utils.HttpPostBytesWithCookie("https://web.qun.qq.com/cgi-bin/announce/add_qun_notice", []byte(form.Encode()), c.getCookies(), "application/x-www-form-urlencoded")
+	if err != nil {
+		return "", err
+	}
+	rsp := struct {
+		RetCode int    `json:"ec"`
+		Msg     string `json:"em"`
+		Id      string `json:"new_fid"`
+	}{}
+	if err = json.Unmarshal(b, &rsp); err != nil {
+		return "", err
+	}
+	if rsp.RetCode != 0 {
+		return "", errors.New(rsp.Msg)
+	}
+	return rsp.Id, nil
+}
+
+// DeleteAnnouncement 删除群公告
+func (g *GroupInfo) DeleteAnnouncement(id string) error {
+	c := g.client
+	form := url.Values{}
+	form.Set("qid", fmt.Sprint(g.Code))
+	form.Set("bkn", fmt.Sprint(c.getCSRFToken()))
+	form.Set("fid", id)
+	b, err := utils.HttpPostBytesWithCookie("https://web.qun.qq.com/cgi-bin/announce/del_feed", []byte(form.Encode()), c.getCookies(), "application/x-www-form-urlencoded")
+	if err != nil {
+		return err
+	}
+	rsp := struct {
+		RetCode int    `json:"ec"`
+		Msg     string `json:"em"`
+	}{}
+	if err = json.Unmarshal(b, &rsp); err != nil {
+		return err
+	}
+	if rsp.RetCode != 0 {
+		return errors.New(rsp.Msg)
+	}
+	return nil
+}
+
+func (c *QQClient) uploadAnnouncementImage(img []byte) (*announcementImage, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	_ = w.WriteField("bkn", strconv.Itoa(c.getCSRFToken()))
+	_ = w.WriteField("source", "troopNotice")
+	_ = w.WriteField("m", "0")
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="pic_up"; filename="temp_uploadFile.png"`)
+	h.Set("Content-Type", "image/png")
+	fw, _ := w.CreatePart(h)
+	_, _ = fw.Write(img)
+	_ = w.Close()
+	b, err := utils.HttpPostBytesWithCookie("https://web.qun.qq.com/cgi-bin/announce/upload_img", buf.Bytes(), c.getCookies(), w.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+	rsp := struct {
+		RetCode int    `json:"ec"`
+		Msg     string `json:"em"`
+		Id      string `json:"id"`
+	}{}
+	if err = json.Unmarshal(b, &rsp); err != nil {
+		return nil, err
+	}
+	if rsp.RetCode != 0 {
+		return nil, errors.New(rsp.Msg)
+	}
+	ret := &announcementImage{}
+	if err = json.Unmarshal([]byte(html.UnescapeString(rsp.Id)), ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// parseAnnouncementCard 解析群公告发布时推送的 com.tencent.mannounce 卡片
+func parseAnnouncementCard(m *message.GroupMessage) *GroupAnnouncementEvent {
+	for _, e := range m.Elements {
+		app, ok := e.(*message.LightAppElement)
+		if !ok {
+			continue
+		}
+		card := struct {
+			App  string `json:"app"`
+			Meta struct {
+				Announce struct {
+					Id     string `json:"fid"`
+					Encode int32  `json:"encode"`
+					Text   string `json:"text"`
+				} `json:"mannounce"`
+			} `json:"meta"`
+		}{}
+		if json.Unmarshal([]byte(app.Content), &card) != nil || card.App != "com.tencent.mannounce" {
+			continue
+		}
+		text := card.Meta.Announce.Text
+		if card.Meta.Announce.Encode == 1 {
+			if b, err := base64.StdEncoding.DecodeString(text); err == nil {
+				text = string(b)
+			}
+		}
+		return &GroupAnnouncementEvent{
+			GroupCode: m.GroupCode,
+			Sender:    m.Sender.Uin,
+			Id:        card.Meta.Announce.Id,
+			Content:   text,
+		}
+	}
+	return nil
+}
+
+func boolToIntString(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,327 @@
+package client
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"github.com/Mrs4s/MiraiGo/message"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	ErrMessageNotFound = errors.New("message not found")
+	ErrStoreClosed     = errors.New("message store closed")
+	ErrStoreBusy       = errors.New("message store write queue full")
+)
+
+// fileStoreMaxPending 等待写入的消息上限, 超出时丢弃新消息, 避免阻塞收消息
+const fileStoreMaxPending = 4096
+
+// MessageStore 保存收发过的消息, 私聊及临时会话消息以对方 uin 区分会话
+type MessageStore interface {
+	SaveGroupMessage(m *message.GroupMessage) error
+	SavePrivateMessage(peer int64, m *message.PrivateMessage) error
+	SaveTempMessage(peer int64, m *message.TempMessage) error
+	LoadGroupMessage(groupCode int64, id int32) (*message.GroupMessage, error)
+	LoadPrivateMessage(peer int64, id int32) (*message.PrivateMessage, error)
+	LoadTempMessage(peer int64, id int32) (*message.TempMessage, error)
+}
+
+type messageKind int8
+
+const (
+	privateKind messageKind = iota
+	groupKind
+	tempKind
+)
+
+type messageKey struct {
+	kind messageKind
+	peer int64
+	id   int32
+}
+
+type lruEntry struct {
+	key messageKey
+	msg interface{}
+}
+
+// LRUMessageStore 内存中的消息存储, 超出容量时淘汰最久未访问的消息
+type LRUMessageStore struct {
+	capacity int
+	list     *list.List
+	items    map[messageKey]*list.Element
+	lock     *sync.Mutex
+}
+
+// FileMessageStore 以 JSON 文件保存消息, 每条消息一个文件.
+// 写入在后台进行, 超过 maxAge 的消息会被定期清理
+type FileMessageStore struct {
+	dir     string
+	maxAge  time.Duration
+	notify  chan struct{}
+	stop    chan struct{}
+	pending map[string]*[]byte
+	closed  bool
+	lock    *sync.Mutex
+	done    chan struct{}
+}
+
+// logStoreError 保存失败不影响消息分发, 只记录日志
+func logStoreError(err error) {
+	if err != nil {
+		log.Println("message store save error:", err)
+	}
+}
+
+func NewLRUMessageStore(capacity int) *LRUMessageStore {
+	return &LRUMessageStore{
+		capacity: capacity,
+		list:     list.New(),
+		items:    make(map[messageKey]*list.Element),
+		lock:     new(sync.Mutex),
+	}
+}
+
+func (s *LRUMessageStore) SaveGroupMessage(m *message.GroupMessage) error {
+	s.put(messageKey{kind: groupKind, peer: m.GroupCode, id: m.Id}, m)
+	return nil
+}
+
+func (s *LRUMessageStore) SavePrivateMessage(peer int64, m *message.PrivateMessage) error {
+	s.put(messageKey{kind: privateKind, peer: peer, id: m.Id}, m)
+	return nil
+}
+
+func (s *LRUMessageStore) SaveTempMessage(peer int64, m *message.TempMessage) error {
+	s.put(messageKey{kind: tempKind, peer: peer, id: m.Id}, m)
+	return nil
+}
+
+func (s *LRUMessageStore) LoadGroupMessage(groupCode int64, id int32) (*message.GroupMessage, error) {
+	if m, ok := s.get(messageKey{kind: groupKind, peer: groupCode, id: id}); ok {
+		return m.(*message.GroupMessage), nil
+	}
+	return nil, ErrMessageNotFound
+}
+
+func (s *LRUMessageStore) LoadPrivateMessage(peer int64, id int32) (*message.PrivateMessage, error) {
+	if m, ok := s.get(messageKey{kind: privateKind, peer: peer, id: id}); ok {
+		return m.(*message.PrivateMessage), nil
+	}
+	return nil, ErrMessageNotFound
+}
+
+func (s *LRUMessageStore) LoadTempMessage(peer int64, id int32) (*message.TempMessage, error) {
+	if m, ok := s.get(messageKey{kind: tempKind, peer: peer, id: id}); ok {
+		return m.(*message.TempMessage), nil
+	}
+	return nil, ErrMessageNotFound
+}
+
+func (s *LRUMessageStore) put(key messageKey, m interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if e, ok := s.items[key]; ok {
+		e.Value.(*lruEntry).msg = m
+		s.list.MoveToFront(e)
+		return
+	}
+	s.items[key] = s.list.PushFront(&lruEntry{key: key, msg: m})
+	for s.capacity > 0 && s.list.Len() > s.capacity {
+		last := s.list.Back()
+		s.list.Remove(last)
+		delete(s.items, last.Value.(*lruEntry).key)
+	}
+}
+
+func (s *LRUMessageStore) get(key messageKey) (interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	e, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	s.list.MoveToFront(e)
+	return e.Value.(*lruEntry).msg, true
+}
+
+// NewFileMessageStore maxAge 为 0 时不清理旧消息. 不再使用时需调用 Close 写入剩余消息
+func NewFileMessageStore(dir string, maxAge time.Duration) (*FileMessageStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &FileMessageStore{
+		dir:     dir,
+		maxAge:  maxAge,
+		notify:  make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		pending: make(map[string]*[]byte),
+		lock:    new(sync.Mutex),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *FileMessageStore) SaveGroupMessage(m *message.GroupMessage) error {
+	return s.write(s.path("group", m.GroupCode, m.Id), m)
+}
+
+func (s *FileMessageStore) SavePrivateMessage(peer int64, m *message.PrivateMessage) error {
+	return s.write(s.path("private", peer, m.Id), m)
+}
+
+func (s *FileMessageStore) SaveTempMessage(peer int64, m *message.TempMessage) error {
+	return s.write(s.path("temp", peer, m.Id), m)
+}
+
+func (s *FileMessageStore) LoadGroupMessage(groupCode int64, id int32) (*message.GroupMessage, error) {
+	m := &message.GroupMessage{}
+	if err := s.read(s.path("group", groupCode, id), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *FileMessageStore) LoadPrivateMessage(peer int64, id int32) (*message.PrivateMessage, error) {
+	m := &message.PrivateMessage{}
+	if err := s.read(s.path("private", peer, id), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *FileMessageStore) LoadTempMessage(peer int64, id int32) (*message.TempMessage, error) {
+	m := &message.TempMessage{}
+	if err := s.read(s.path("temp", peer, id), m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Close 等待排队中的消息写入完成, 之后保存消息将返回 ErrStoreClosed
+func (s *FileMessageStore) Close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.lock.Unlock()
+	close(s.stop)
+	<-s.done
+	return nil
+}
+
+func (s *FileMessageStore) path(kind string, peer int64, id int32) string {
+	return path.Join(s.dir, kind, strconv.FormatInt(peer, 10), strconv.FormatInt(int64(id), 10)+".json")
+}
+
+// write 序列化后放入待写入列表, 由 run 在后台写入文件, 不会阻塞调用方
+func (s *FileMessageStore) write(p string, m interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return ErrStoreClosed
+	}
+	if _, ok := s.pending[p]; !ok && len(s.pending) >= fileStoreMaxPending {
+		s.lock.Unlock()
+		return ErrStoreBusy
+	}
+	s.pending[p] = &b
+	s.lock.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *FileMessageStore) read(p string, m interface{}) error {
+	s.lock.Lock()
+	b, ok := s.pending[p]
+	s.lock.Unlock()
+	if ok {
+		return json.Unmarshal(*b, m)
+	}
+	data, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return ErrMessageNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, m)
+}
+
+func (s *FileMessageStore) run() {
+	defer close(s.done)
+	var tick <-chan time.Time
+	if s.maxAge > 0 {
+		s.prune()
+		t := time.NewTicker(time.Hour)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		select {
+		case <-s.notify:
+			s.flush()
+		case <-tick:
+			s.prune()
+		case <-s.stop:
+			s.flush()
+			return
+		}
+	}
+}
+
+// flush 写入当前所有待写入的消息
+func (s *FileMessageStore) flush() {
+	s.lock.Lock()
+	batch := make(map[string]*[]byte, len(s.pending))
+	for p, b := range s.pending {
+		batch[p] = b
+	}
+	s.lock.Unlock()
+	for p, b := range batch {
+		err := os.MkdirAll(path.Dir(p), 0755)
+		if err == nil {
+			err = ioutil.WriteFile(p, *b, 0644)
+		}
+		if err != nil {
+			log.Println("message store write error:", err)
+		}
+		s.lock.Lock()
+		if s.pending[p] == b {
+			delete(s.pending, p)
+		}
+		s.lock.Unlock()
+	}
+}
+
+// prune 删除修改时间早于 maxAge 的消息文件
+func (s *FileMessageStore) prune() {
+	deadline := time.Now().Add(-s.maxAge)
+	_ = filepath.Walk(s.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(p) != ".json" {
+			return nil
+		}
+		if info.ModTime().Before(deadline) {
+			_ = os.Remove(p)
+		}
+		return nil
+	})
+}
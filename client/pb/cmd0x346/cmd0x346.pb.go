@@ -0,0 +1,1235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.11.4
+// source: cmd0x346.proto
+
+package cmd0x346
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type C346ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd              int32             `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Seq              int32             `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	ApplyDownloadReq *ApplyDownloadReq `protobuf:"bytes,14,opt,name=applyDownloadReq,proto3" json:"applyDownloadReq,omitempty"`
+	ApplyUploadReqV3 *ApplyUploadReqV3 `protobuf:"bytes,19,opt,name=applyUploadReqV3,proto3" json:"applyUploadReqV3,omitempty"`
+	ExtensionReq     *ExtensionReq     `protobuf:"bytes,99999,opt,name=extensionReq,proto3" json:"extensionReq,omitempty"`
+	BusinessId       int32             `protobuf:"varint,101,opt,name=businessId,proto3" json:"businessId,omitempty"`
+	ClientType       int32             `protobuf:"varint,102,opt,name=clientType,proto3" json:"clientType,omitempty"`
+}
+
+func (x *C346ReqBody) Reset() {
+	*x = C346ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C346ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C346ReqBody) ProtoMessage() {}
+
+func (x *C346ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C346ReqBody.ProtoReflect.Descriptor instead.
+func (*C346ReqBody) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *C346ReqBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *C346ReqBody) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *C346ReqBody) GetApplyDownloadReq() *ApplyDownloadReq {
+	if x != nil {
+		return x.ApplyDownloadReq
+	}
+	return nil
+}
+
+func (x *C346ReqBody) GetApplyUploadReqV3() *ApplyUploadReqV3 {
+	if x != nil {
+		return x.ApplyUploadReqV3
+	}
+	return nil
+}
+
+func (x *C346ReqBody) GetExtensionReq() *ExtensionReq {
+	if x != nil {
+		return x.ExtensionReq
+	}
+	return nil
+}
+
+func (x *C346ReqBody) GetBusinessId() int32 {
+	if x != nil {
+		return x.BusinessId
+	}
+	return 0
+}
+
+func (x *C346ReqBody) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+type C346RspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd              int32             `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Seq              int32             `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	ApplyDownloadRsp *ApplyDownloadRsp `protobuf:"bytes,14,opt,name=applyDownloadRsp,proto3" json:"applyDownloadRsp,omitempty"`
+	ApplyUploadRspV3 *ApplyUploadRspV3 `protobuf:"bytes,19,opt,name=applyUploadRspV3,proto3" json:"applyUploadRspV3,omitempty"`
+	BusinessId       int32             `protobuf:"varint,101,opt,name=businessId,proto3" json:"businessId,omitempty"`
+	ClientType       int32             `protobuf:"varint,102,opt,name=clientType,proto3" json:"clientType,omitempty"`
+}
+
+func (x *C346RspBody) Reset() {
+	*x = C346RspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C346RspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C346RspBody) ProtoMessage() {}
+
+func (x *C346RspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C346RspBody.ProtoReflect.Descriptor instead.
+func (*C346RspBody) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *C346RspBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *C346RspBody) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *C346RspBody) GetApplyDownloadRsp() *ApplyDownloadRsp {
+	if x != nil {
+		return x.ApplyDownloadRsp
+	}
+	return nil
+}
+
+func (x *C346RspBody) GetApplyUploadRspV3() *ApplyUploadRspV3 {
+	if x != nil {
+		return x.ApplyUploadRspV3
+	}
+	return nil
+}
+
+func (x *C346RspBody) GetBusinessId() int32 {
+	if x != nil {
+		return x.BusinessId
+	}
+	return 0
+}
+
+func (x *C346RspBody) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+type ApplyDownloadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin       int64  `protobuf:"varint,10,opt,name=uin,proto3" json:"uin,omitempty"`
+	Uuid      []byte `protobuf:"bytes,20,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OwnerType int32  `protobuf:"varint,30,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+	ExtIntype int32  `protobuf:"varint,500,opt,name=extIntype,proto3" json:"extIntype,omitempty"`
+}
+
+func (x *ApplyDownloadReq) Reset() {
+	*x = ApplyDownloadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDownloadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDownloadReq) ProtoMessage() {}
+
+func (x *ApplyDownloadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDownloadReq.ProtoReflect.Descriptor instead.
+func (*ApplyDownloadReq) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyDownloadReq) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *ApplyDownloadReq) GetUuid() []byte {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *ApplyDownloadReq) GetOwnerType() int32 {
+	if x != nil {
+		return x.OwnerType
+	}
+	return 0
+}
+
+func (x *ApplyDownloadReq) GetExtIntype() int32 {
+	if x != nil {
+		return x.ExtIntype
+	}
+	return 0
+}
+
+type ApplyDownloadRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode      int32         `protobuf:"varint,10,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg       string        `protobuf:"bytes,20,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	DownloadInfo *DownloadInfo `protobuf:"bytes,30,opt,name=downloadInfo,proto3" json:"downloadInfo,omitempty"`
+	FileInfo     *FileInfo     `protobuf:"bytes,40,opt,name=fileInfo,proto3" json:"fileInfo,omitempty"`
+}
+
+func (x *ApplyDownloadRsp) Reset() {
+	*x = ApplyDownloadRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDownloadRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDownloadRsp) ProtoMessage() {}
+
+func (x *ApplyDownloadRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDownloadRsp.ProtoReflect.Descriptor instead.
+func (*ApplyDownloadRsp) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApplyDownloadRsp) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *ApplyDownloadRsp) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *ApplyDownloadRsp) GetDownloadInfo() *DownloadInfo {
+	if x != nil {
+		return x.DownloadInfo
+	}
+	return nil
+}
+
+func (x *ApplyDownloadRsp) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+type DownloadInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadKey    []byte   `protobuf:"bytes,10,opt,name=downloadKey,proto3" json:"downloadKey,omitempty"`
+	DownloadIp     string   `protobuf:"bytes,20,opt,name=downloadIp,proto3" json:"downloadIp,omitempty"`
+	DownloadDomain string   `protobuf:"bytes,30,opt,name=downloadDomain,proto3" json:"downloadDomain,omitempty"`
+	Port           int32    `protobuf:"varint,40,opt,name=port,proto3" json:"port,omitempty"`
+	DownloadUrl    string   `protobuf:"bytes,50,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	DownloadipList []string `protobuf:"bytes,60,rep,name=downloadipList,proto3" json:"downloadipList,omitempty"`
+	Cookie         string   `protobuf:"bytes,70,opt,name=cookie,proto3" json:"cookie,omitempty"`
+}
+
+func (x *DownloadInfo) Reset() {
+	*x = DownloadInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInfo) ProtoMessage() {}
+
+func (x *DownloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInfo.ProtoReflect.Descriptor instead.
+func (*DownloadInfo) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadInfo) GetDownloadKey() []byte {
+	if x != nil {
+		return x.DownloadKey
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetDownloadIp() string {
+	if x != nil {
+		return x.DownloadIp
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetDownloadDomain() string {
+	if x != nil {
+		return x.DownloadDomain
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DownloadInfo) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetDownloadipList() []string {
+	if x != nil {
+		return x.DownloadipList
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetCookie() string {
+	if x != nil {
+		return x.Cookie
+	}
+	return ""
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin         int64  `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	DangerEvel  int32  `protobuf:"varint,2,opt,name=dangerEvel,proto3" json:"dangerEvel,omitempty"`
+	FileSize    int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	LifeTime    int32  `protobuf:"varint,4,opt,name=lifeTime,proto3" json:"lifeTime,omitempty"`
+	UploadTime  int32  `protobuf:"varint,5,opt,name=uploadTime,proto3" json:"uploadTime,omitempty"`
+	Uuid        []byte `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	FileName    string `protobuf:"bytes,7,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	AbsFileType int32  `protobuf:"varint,8,opt,name=absFileType,proto3" json:"absFileType,omitempty"`
+	Md5_10M     []byte `protobuf:"bytes,9,opt,name=md5_10M,json=md510M,proto3" json:"md5_10M,omitempty"`
+	Sha         []byte `protobuf:"bytes,10,opt,name=sha,proto3" json:"sha,omitempty"`
+	ClientType  int32  `protobuf:"varint,11,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	OwnerUin    int64  `protobuf:"varint,12,opt,name=ownerUin,proto3" json:"ownerUin,omitempty"`
+	PeerUin     int64  `protobuf:"varint,13,opt,name=peerUin,proto3" json:"peerUin,omitempty"`
+	ExpireTime  int32  `protobuf:"varint,14,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FileInfo) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *FileInfo) GetDangerEvel() int32 {
+	if x != nil {
+		return x.DangerEvel
+	}
+	return 0
+}
+
+func (x *FileInfo) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *FileInfo) GetLifeTime() int32 {
+	if x != nil {
+		return x.LifeTime
+	}
+	return 0
+}
+
+func (x *FileInfo) GetUploadTime() int32 {
+	if x != nil {
+		return x.UploadTime
+	}
+	return 0
+}
+
+func (x *FileInfo) GetUuid() []byte {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *FileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileInfo) GetAbsFileType() int32 {
+	if x != nil {
+		return x.AbsFileType
+	}
+	return 0
+}
+
+func (x *FileInfo) GetMd5_10M() []byte {
+	if x != nil {
+		return x.Md5_10M
+	}
+	return nil
+}
+
+func (x *FileInfo) GetSha() []byte {
+	if x != nil {
+		return x.Sha
+	}
+	return nil
+}
+
+func (x *FileInfo) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *FileInfo) GetOwnerUin() int64 {
+	if x != nil {
+		return x.OwnerUin
+	}
+	return 0
+}
+
+func (x *FileInfo) GetPeerUin() int64 {
+	if x != nil {
+		return x.PeerUin
+	}
+	return 0
+}
+
+func (x *FileInfo) GetExpireTime() int32 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type ApplyUploadReqV3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderUin     int64  `protobuf:"varint,10,opt,name=senderUin,proto3" json:"senderUin,omitempty"`
+	RecverUin     int64  `protobuf:"varint,20,opt,name=recverUin,proto3" json:"recverUin,omitempty"`
+	FileSize      int64  `protobuf:"varint,30,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	FileName      string `protobuf:"bytes,40,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Md5_10M       []byte `protobuf:"bytes,50,opt,name=md5_10M,json=md510M,proto3" json:"md5_10M,omitempty"`
+	Sha           []byte `protobuf:"bytes,60,opt,name=sha,proto3" json:"sha,omitempty"`
+	LocalFilepath string `protobuf:"bytes,70,opt,name=localFilepath,proto3" json:"localFilepath,omitempty"`
+	DangerLevel   int32  `protobuf:"varint,80,opt,name=dangerLevel,proto3" json:"dangerLevel,omitempty"`
+	TotalSpace    int64  `protobuf:"varint,90,opt,name=totalSpace,proto3" json:"totalSpace,omitempty"`
+}
+
+func (x *ApplyUploadReqV3) Reset() {
+	*x = ApplyUploadReqV3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyUploadReqV3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyUploadReqV3) ProtoMessage() {}
+
+func (x *ApplyUploadReqV3) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyUploadReqV3.ProtoReflect.Descriptor instead.
+func (*ApplyUploadReqV3) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplyUploadReqV3) GetSenderUin() int64 {
+	if x != nil {
+		return x.SenderUin
+	}
+	return 0
+}
+
+func (x *ApplyUploadReqV3) GetRecverUin() int64 {
+	if x != nil {
+		return x.RecverUin
+	}
+	return 0
+}
+
+func (x *ApplyUploadReqV3) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ApplyUploadReqV3) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ApplyUploadReqV3) GetMd5_10M() []byte {
+	if x != nil {
+		return x.Md5_10M
+	}
+	return nil
+}
+
+func (x *ApplyUploadReqV3) GetSha() []byte {
+	if x != nil {
+		return x.Sha
+	}
+	return nil
+}
+
+func (x *ApplyUploadReqV3) GetLocalFilepath() string {
+	if x != nil {
+		return x.LocalFilepath
+	}
+	return ""
+}
+
+func (x *ApplyUploadReqV3) GetDangerLevel() int32 {
+	if x != nil {
+		return x.DangerLevel
+	}
+	return 0
+}
+
+func (x *ApplyUploadReqV3) GetTotalSpace() int64 {
+	if x != nil {
+		return x.TotalSpace
+	}
+	return 0
+}
+
+type ApplyUploadRspV3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32    `protobuf:"varint,10,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string   `protobuf:"bytes,20,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	TotalSpace    int64    `protobuf:"varint,30,opt,name=totalSpace,proto3" json:"totalSpace,omitempty"`
+	UsedSpace     int64    `protobuf:"varint,40,opt,name=usedSpace,proto3" json:"usedSpace,omitempty"`
+	UploadedSize  int64    `protobuf:"varint,50,opt,name=uploadedSize,proto3" json:"uploadedSize,omitempty"`
+	UploadIp      string   `protobuf:"bytes,60,opt,name=uploadIp,proto3" json:"uploadIp,omitempty"`
+	UploadDomain  string   `protobuf:"bytes,70,opt,name=uploadDomain,proto3" json:"uploadDomain,omitempty"`
+	UploadPort    int32    `protobuf:"varint,80,opt,name=uploadPort,proto3" json:"uploadPort,omitempty"`
+	Uuid          []byte   `protobuf:"bytes,90,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UploadKey     []byte   `protobuf:"bytes,100,opt,name=uploadKey,proto3" json:"uploadKey,omitempty"`
+	BoolFileExist bool     `protobuf:"varint,110,opt,name=boolFileExist,proto3" json:"boolFileExist,omitempty"`
+	PackSize      int32    `protobuf:"varint,120,opt,name=packSize,proto3" json:"packSize,omitempty"`
+	UploadIpList  []string `protobuf:"bytes,130,rep,name=uploadIpList,proto3" json:"uploadIpList,omitempty"`
+}
+
+func (x *ApplyUploadRspV3) Reset() {
+	*x = ApplyUploadRspV3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyUploadRspV3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyUploadRspV3) ProtoMessage() {}
+
+func (x *ApplyUploadRspV3) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyUploadRspV3.ProtoReflect.Descriptor instead.
+func (*ApplyUploadRspV3) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplyUploadRspV3) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *ApplyUploadRspV3) GetTotalSpace() int64 {
+	if x != nil {
+		return x.TotalSpace
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetUsedSpace() int64 {
+	if x != nil {
+		return x.UsedSpace
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetUploadedSize() int64 {
+	if x != nil {
+		return x.UploadedSize
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetUploadIp() string {
+	if x != nil {
+		return x.UploadIp
+	}
+	return ""
+}
+
+func (x *ApplyUploadRspV3) GetUploadDomain() string {
+	if x != nil {
+		return x.UploadDomain
+	}
+	return ""
+}
+
+func (x *ApplyUploadRspV3) GetUploadPort() int32 {
+	if x != nil {
+		return x.UploadPort
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetUuid() []byte {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *ApplyUploadRspV3) GetUploadKey() []byte {
+	if x != nil {
+		return x.UploadKey
+	}
+	return nil
+}
+
+func (x *ApplyUploadRspV3) GetBoolFileExist() bool {
+	if x != nil {
+		return x.BoolFileExist
+	}
+	return false
+}
+
+func (x *ApplyUploadRspV3) GetPackSize() int32 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+func (x *ApplyUploadRspV3) GetUploadIpList() []string {
+	if x != nil {
+		return x.UploadIpList
+	}
+	return nil
+}
+
+type ExtensionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            int64 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	DownloadUrlType int32 `protobuf:"varint,90200,opt,name=downloadUrlType,proto3" json:"downloadUrlType,omitempty"`
+}
+
+func (x *ExtensionReq) Reset() {
+	*x = ExtensionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cmd0x346_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionReq) ProtoMessage() {}
+
+func (x *ExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd0x346_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionReq.ProtoReflect.Descriptor instead.
+func (*ExtensionReq) Descriptor() ([]byte, []int) {
+	return file_cmd0x346_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExtensionReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExtensionReq) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExtensionReq) GetDownloadUrlType() int32 {
+	if x != nil {
+		return x.DownloadUrlType
+	}
+	return 0
+}
+
+var File_cmd0x346_proto protoreflect.FileDescriptor
+
+var file_cmd0x346_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x63, 0x6d, 0x64, 0x30, 0x78, 0x33, 0x34, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xa4, 0x02, 0x0a, 0x0b, 0x43, 0x33, 0x34, 0x36, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63,
+	0x6d, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x56, 0x33, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x56, 0x33,
+	0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x56, 0x33, 0x12, 0x33, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x18, 0x9f, 0x8d, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x43, 0x33, 0x34, 0x36,
+	0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x56, 0x33, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x73, 0x70, 0x56, 0x33, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x56, 0x33, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x75, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x79, 0x70, 0x65, 0x18, 0xf4,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xee, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x22, 0x87, 0x03, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x61, 0x62, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x64, 0x35, 0x5f, 0x31, 0x30, 0x4d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x64,
+	0x35, 0x31, 0x30, 0x4d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55,
+	0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55,
+	0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x99, 0x02, 0x0a,
+	0x10, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x56,
+	0x33, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x63, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x64, 0x35, 0x5f, 0x31, 0x30, 0x4d,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x64, 0x35, 0x31, 0x30, 0x4d, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x68, 0x61,
+	0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x6e,
+	0x67, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0x9f, 0x03, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x56, 0x33, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73,
+	0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x12, 0x22, 0x0a,
+	0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x46, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b,
+	0x65, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x6f, 0x6f, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x63,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x82, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x0c, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a,
+	0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0xd8, 0xc0, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b,
+	0x63, 0x6d, 0x64, 0x30, 0x78, 0x33, 0x34, 0x36, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cmd0x346_proto_rawDescOnce sync.Once
+	file_cmd0x346_proto_rawDescData = file_cmd0x346_proto_rawDesc
+)
+
+func file_cmd0x346_proto_rawDescGZIP() []byte {
+	file_cmd0x346_proto_rawDescOnce.Do(func() {
+		file_cmd0x346_proto_rawDescData = protoimpl.X.CompressGZIP(file_cmd0x346_proto_rawDescData)
+	})
+	return file_cmd0x346_proto_rawDescData
+}
+
+var file_cmd0x346_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cmd0x346_proto_goTypes = []interface{}{
+	(*C346ReqBody)(nil),      // 0: C346ReqBody
+	(*C346RspBody)(nil),      // 1: C346RspBody
+	(*ApplyDownloadReq)(nil), // 2: ApplyDownloadReq
+	(*ApplyDownloadRsp)(nil), // 3: ApplyDownloadRsp
+	(*DownloadInfo)(nil),     // 4: DownloadInfo
+	(*FileInfo)(nil),         // 5: FileInfo
+	(*ApplyUploadReqV3)(nil), // 6: ApplyUploadReqV3
+	(*ApplyUploadRspV3)(nil), // 7: ApplyUploadRspV3
+	(*ExtensionReq)(nil),     // 8: ExtensionReq
+}
+var file_cmd0x346_proto_depIdxs = []int32{
+	2, // 0: C346ReqBody.applyDownloadReq:type_name -> ApplyDownloadReq
+	6, // 1: C346ReqBody.applyUploadReqV3:type_name -> ApplyUploadReqV3
+	8, // 2: C346ReqBody.extensionReq:type_name -> ExtensionReq
+	3, // 3: C346RspBody.applyDownloadRsp:type_name -> ApplyDownloadRsp
+	7, // 4: C346RspBody.applyUploadRspV3:type_name -> ApplyUploadRspV3
+	4, // 5: ApplyDownloadRsp.downloadInfo:type_name -> DownloadInfo
+	5, // 6: ApplyDownloadRsp.fileInfo:type_name -> FileInfo
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cmd0x346_proto_init() }
+func file_cmd0x346_proto_init() {
+	if File_cmd0x346_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cmd0x346_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C346ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C346RspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDownloadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDownloadRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyUploadReqV3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyUploadRspV3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cmd0x346_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtensionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cmd0x346_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd0x346_proto_goTypes,
+		DependencyIndexes: file_cmd0x346_proto_depIdxs,
+		MessageInfos:      file_cmd0x346_proto_msgTypes,
+	}.Build()
+	File_cmd0x346_proto = out.File
+	file_cmd0x346_proto_rawDesc = nil
+	file_cmd0x346_proto_goTypes = nil
+	file_cmd0x346_proto_depIdxs = nil
+}
@@ -1479,8 +1479,12 @@ type NotifyMsgBody struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OptMsgRecall *MessageRecallReminder `protobuf:"bytes,11,opt,name=optMsgRecall,proto3" json:"optMsgRecall,omitempty"`
-	ServiceType  int32                  `protobuf:"varint,13,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	OptMsgGrayTips    *AIOGrayTipsInfo       `protobuf:"bytes,5,opt,name=optMsgGrayTips,proto3" json:"optMsgGrayTips,omitempty"`
+	OptMsgRedTips     *RedGrayTipsInfo       `protobuf:"bytes,9,opt,name=optMsgRedTips,proto3" json:"optMsgRedTips,omitempty"`
+	OptMsgRecall      *MessageRecallReminder `protobuf:"bytes,11,opt,name=optMsgRecall,proto3" json:"optMsgRecall,omitempty"`
+	ServiceType       int32                  `protobuf:"varint,13,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	OptGeneralGrayTip *GeneralGrayTipInfo    `protobuf:"bytes,26,opt,name=optGeneralGrayTip,proto3" json:"optGeneralGrayTip,omitempty"`
+	QqGroupDigestMsg  *QQGroupDigestMsg      `protobuf:"bytes,33,opt,name=qqGroupDigestMsg,proto3" json:"qqGroupDigestMsg,omitempty"`
 }
 
 func (x *NotifyMsgBody) Reset() {
@@ -1515,6 +1519,20 @@ func (*NotifyMsgBody) Descriptor() ([]byte, []int) {
 	return file_data_proto_rawDescGZIP(), []int{15}
 }
 
+func (x *NotifyMsgBody) GetOptMsgGrayTips() *AIOGrayTipsInfo {
+	if x != nil {
+		return x.OptMsgGrayTips
+	}
+	return nil
+}
+
+func (x *NotifyMsgBody) GetOptMsgRedTips() *RedGrayTipsInfo {
+	if x != nil {
+		return x.OptMsgRedTips
+	}
+	return nil
+}
+
 func (x *NotifyMsgBody) GetOptMsgRecall() *MessageRecallReminder {
 	if x != nil {
 		return x.OptMsgRecall
@@ -1529,22 +1547,40 @@ func (x *NotifyMsgBody) GetServiceType() int32 {
 	return 0
 }
 
-type MessageRecallReminder struct {
+func (x *NotifyMsgBody) GetOptGeneralGrayTip() *GeneralGrayTipInfo {
+	if x != nil {
+		return x.OptGeneralGrayTip
+	}
+	return nil
+}
+
+func (x *NotifyMsgBody) GetQqGroupDigestMsg() *QQGroupDigestMsg {
+	if x != nil {
+		return x.QqGroupDigestMsg
+	}
+	return nil
+}
+
+type QQGroupDigestMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uin             int64                  `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
-	Nickname        []byte                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	RecalledMsgList []*RecalledMessageMeta `protobuf:"bytes,3,rep,name=recalledMsgList,proto3" json:"recalledMsgList,omitempty"`
-	ReminderContent []byte                 `protobuf:"bytes,4,opt,name=reminderContent,proto3" json:"reminderContent,omitempty"`
-	Userdef         []byte                 `protobuf:"bytes,5,opt,name=userdef,proto3" json:"userdef,omitempty"`
-	GroupType       int32                  `protobuf:"varint,6,opt,name=groupType,proto3" json:"groupType,omitempty"`
-	OpType          int32                  `protobuf:"varint,7,opt,name=opType,proto3" json:"opType,omitempty"`
-}
-
-func (x *MessageRecallReminder) Reset() {
-	*x = MessageRecallReminder{}
+	GroupCode     int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	Seq           int32  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Random        int32  `protobuf:"varint,3,opt,name=random,proto3" json:"random,omitempty"`
+	OpType        int32  `protobuf:"varint,4,opt,name=opType,proto3" json:"opType,omitempty"`
+	Sender        int64  `protobuf:"varint,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	DigestOper    int64  `protobuf:"varint,6,opt,name=digestOper,proto3" json:"digestOper,omitempty"`
+	OpTime        int64  `protobuf:"varint,7,opt,name=opTime,proto3" json:"opTime,omitempty"`
+	LastestMsgSeq int32  `protobuf:"varint,8,opt,name=lastestMsgSeq,proto3" json:"lastestMsgSeq,omitempty"`
+	OperNick      []byte `protobuf:"bytes,9,opt,name=operNick,proto3" json:"operNick,omitempty"`
+	SenderNick    []byte `protobuf:"bytes,10,opt,name=senderNick,proto3" json:"senderNick,omitempty"`
+	ExtInfo       int32  `protobuf:"varint,11,opt,name=extInfo,proto3" json:"extInfo,omitempty"`
+}
+
+func (x *QQGroupDigestMsg) Reset() {
+	*x = QQGroupDigestMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_data_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1552,13 +1588,13 @@ func (x *MessageRecallReminder) Reset() {
 	}
 }
 
-func (x *MessageRecallReminder) String() string {
+func (x *QQGroupDigestMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageRecallReminder) ProtoMessage() {}
+func (*QQGroupDigestMsg) ProtoMessage() {}
 
-func (x *MessageRecallReminder) ProtoReflect() protoreflect.Message {
+func (x *QQGroupDigestMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_data_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1570,75 +1606,103 @@ func (x *MessageRecallReminder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageRecallReminder.ProtoReflect.Descriptor instead.
-func (*MessageRecallReminder) Descriptor() ([]byte, []int) {
+// Deprecated: Use QQGroupDigestMsg.ProtoReflect.Descriptor instead.
+func (*QQGroupDigestMsg) Descriptor() ([]byte, []int) {
 	return file_data_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MessageRecallReminder) GetUin() int64 {
+func (x *QQGroupDigestMsg) GetGroupCode() int64 {
 	if x != nil {
-		return x.Uin
+		return x.GroupCode
 	}
 	return 0
 }
 
-func (x *MessageRecallReminder) GetNickname() []byte {
+func (x *QQGroupDigestMsg) GetSeq() int32 {
 	if x != nil {
-		return x.Nickname
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageRecallReminder) GetRecalledMsgList() []*RecalledMessageMeta {
+func (x *QQGroupDigestMsg) GetRandom() int32 {
 	if x != nil {
-		return x.RecalledMsgList
+		return x.Random
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageRecallReminder) GetReminderContent() []byte {
+func (x *QQGroupDigestMsg) GetOpType() int32 {
 	if x != nil {
-		return x.ReminderContent
+		return x.OpType
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageRecallReminder) GetUserdef() []byte {
+func (x *QQGroupDigestMsg) GetSender() int64 {
 	if x != nil {
-		return x.Userdef
+		return x.Sender
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageRecallReminder) GetGroupType() int32 {
+func (x *QQGroupDigestMsg) GetDigestOper() int64 {
 	if x != nil {
-		return x.GroupType
+		return x.DigestOper
 	}
 	return 0
 }
 
-func (x *MessageRecallReminder) GetOpType() int32 {
+func (x *QQGroupDigestMsg) GetOpTime() int64 {
 	if x != nil {
-		return x.OpType
+		return x.OpTime
 	}
 	return 0
 }
 
-type RecalledMessageMeta struct {
+func (x *QQGroupDigestMsg) GetLastestMsgSeq() int32 {
+	if x != nil {
+		return x.LastestMsgSeq
+	}
+	return 0
+}
+
+func (x *QQGroupDigestMsg) GetOperNick() []byte {
+	if x != nil {
+		return x.OperNick
+	}
+	return nil
+}
+
+func (x *QQGroupDigestMsg) GetSenderNick() []byte {
+	if x != nil {
+		return x.SenderNick
+	}
+	return nil
+}
+
+func (x *QQGroupDigestMsg) GetExtInfo() int32 {
+	if x != nil {
+		return x.ExtInfo
+	}
+	return 0
+}
+
+type AIOGrayTipsInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seq       int32 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Time      int32 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	MsgRandom int32 `protobuf:"varint,3,opt,name=msgRandom,proto3" json:"msgRandom,omitempty"`
-	MsgType   int32 `protobuf:"varint,4,opt,name=msgType,proto3" json:"msgType,omitempty"`
-	MsgFlag   int32 `protobuf:"varint,5,opt,name=msgFlag,proto3" json:"msgFlag,omitempty"`
-	AuthorUin int64 `protobuf:"varint,6,opt,name=authorUin,proto3" json:"authorUin,omitempty"`
+	ShowLastest    int32  `protobuf:"varint,1,opt,name=showLastest,proto3" json:"showLastest,omitempty"`
+	Content        []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Remind         int32  `protobuf:"varint,3,opt,name=remind,proto3" json:"remind,omitempty"`
+	Brief          []byte `protobuf:"bytes,4,opt,name=brief,proto3" json:"brief,omitempty"`
+	ReceiverUin    int64  `protobuf:"varint,5,opt,name=receiverUin,proto3" json:"receiverUin,omitempty"`
+	ReliaoAdminOpt int32  `protobuf:"varint,6,opt,name=reliaoAdminOpt,proto3" json:"reliaoAdminOpt,omitempty"`
 }
 
-func (x *RecalledMessageMeta) Reset() {
-	*x = RecalledMessageMeta{}
+func (x *AIOGrayTipsInfo) Reset() {
+	*x = AIOGrayTipsInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_data_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1646,13 +1710,13 @@ func (x *RecalledMessageMeta) Reset() {
 	}
 }
 
-func (x *RecalledMessageMeta) String() string {
+func (x *AIOGrayTipsInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecalledMessageMeta) ProtoMessage() {}
+func (*AIOGrayTipsInfo) ProtoMessage() {}
 
-func (x *RecalledMessageMeta) ProtoReflect() protoreflect.Message {
+func (x *AIOGrayTipsInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_data_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,63 +1728,72 @@ func (x *RecalledMessageMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecalledMessageMeta.ProtoReflect.Descriptor instead.
-func (*RecalledMessageMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use AIOGrayTipsInfo.ProtoReflect.Descriptor instead.
+func (*AIOGrayTipsInfo) Descriptor() ([]byte, []int) {
 	return file_data_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RecalledMessageMeta) GetSeq() int32 {
+func (x *AIOGrayTipsInfo) GetShowLastest() int32 {
 	if x != nil {
-		return x.Seq
+		return x.ShowLastest
 	}
 	return 0
 }
 
-func (x *RecalledMessageMeta) GetTime() int32 {
+func (x *AIOGrayTipsInfo) GetContent() []byte {
 	if x != nil {
-		return x.Time
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-func (x *RecalledMessageMeta) GetMsgRandom() int32 {
+func (x *AIOGrayTipsInfo) GetRemind() int32 {
 	if x != nil {
-		return x.MsgRandom
+		return x.Remind
 	}
 	return 0
 }
 
-func (x *RecalledMessageMeta) GetMsgType() int32 {
+func (x *AIOGrayTipsInfo) GetBrief() []byte {
 	if x != nil {
-		return x.MsgType
+		return x.Brief
 	}
-	return 0
+	return nil
 }
 
-func (x *RecalledMessageMeta) GetMsgFlag() int32 {
+func (x *AIOGrayTipsInfo) GetReceiverUin() int64 {
 	if x != nil {
-		return x.MsgFlag
+		return x.ReceiverUin
 	}
 	return 0
 }
 
-func (x *RecalledMessageMeta) GetAuthorUin() int64 {
+func (x *AIOGrayTipsInfo) GetReliaoAdminOpt() int32 {
 	if x != nil {
-		return x.AuthorUin
+		return x.ReliaoAdminOpt
 	}
 	return 0
 }
 
-type SubD4 struct {
+type RedGrayTipsInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uin int64 `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	ShowLastest         int32  `protobuf:"varint,1,opt,name=showLastest,proto3" json:"showLastest,omitempty"`
+	SenderUin           int64  `protobuf:"varint,2,opt,name=senderUin,proto3" json:"senderUin,omitempty"`
+	ReceiverUin         int64  `protobuf:"varint,3,opt,name=receiverUin,proto3" json:"receiverUin,omitempty"`
+	SenderRichContent   []byte `protobuf:"bytes,4,opt,name=senderRichContent,proto3" json:"senderRichContent,omitempty"`
+	ReceiverRichContent []byte `protobuf:"bytes,5,opt,name=receiverRichContent,proto3" json:"receiverRichContent,omitempty"`
+	Authkey             []byte `protobuf:"bytes,6,opt,name=authkey,proto3" json:"authkey,omitempty"`
+	Msgtype             int32  `protobuf:"zigzag32,7,opt,name=msgtype,proto3" json:"msgtype,omitempty"`
+	LuckyFlag           int32  `protobuf:"varint,8,opt,name=luckyFlag,proto3" json:"luckyFlag,omitempty"`
+	HideFlag            int32  `protobuf:"varint,9,opt,name=hideFlag,proto3" json:"hideFlag,omitempty"`
+	LuckyUin            int64  `protobuf:"varint,12,opt,name=luckyUin,proto3" json:"luckyUin,omitempty"`
 }
 
-func (x *SubD4) Reset() {
-	*x = SubD4{}
+func (x *RedGrayTipsInfo) Reset() {
+	*x = RedGrayTipsInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_data_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,13 +1801,13 @@ func (x *SubD4) Reset() {
 	}
 }
 
-func (x *SubD4) String() string {
+func (x *RedGrayTipsInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubD4) ProtoMessage() {}
+func (*RedGrayTipsInfo) ProtoMessage() {}
 
-func (x *SubD4) ProtoReflect() protoreflect.Message {
+func (x *RedGrayTipsInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_data_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1746,32 +1819,98 @@ func (x *SubD4) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubD4.ProtoReflect.Descriptor instead.
-func (*SubD4) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedGrayTipsInfo.ProtoReflect.Descriptor instead.
+func (*RedGrayTipsInfo) Descriptor() ([]byte, []int) {
 	return file_data_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SubD4) GetUin() int64 {
+func (x *RedGrayTipsInfo) GetShowLastest() int32 {
 	if x != nil {
-		return x.Uin
+		return x.ShowLastest
 	}
 	return 0
 }
 
-type Sub8A struct {
+func (x *RedGrayTipsInfo) GetSenderUin() int64 {
+	if x != nil {
+		return x.SenderUin
+	}
+	return 0
+}
+
+func (x *RedGrayTipsInfo) GetReceiverUin() int64 {
+	if x != nil {
+		return x.ReceiverUin
+	}
+	return 0
+}
+
+func (x *RedGrayTipsInfo) GetSenderRichContent() []byte {
+	if x != nil {
+		return x.SenderRichContent
+	}
+	return nil
+}
+
+func (x *RedGrayTipsInfo) GetReceiverRichContent() []byte {
+	if x != nil {
+		return x.ReceiverRichContent
+	}
+	return nil
+}
+
+func (x *RedGrayTipsInfo) GetAuthkey() []byte {
+	if x != nil {
+		return x.Authkey
+	}
+	return nil
+}
+
+func (x *RedGrayTipsInfo) GetMsgtype() int32 {
+	if x != nil {
+		return x.Msgtype
+	}
+	return 0
+}
+
+func (x *RedGrayTipsInfo) GetLuckyFlag() int32 {
+	if x != nil {
+		return x.LuckyFlag
+	}
+	return 0
+}
+
+func (x *RedGrayTipsInfo) GetHideFlag() int32 {
+	if x != nil {
+		return x.HideFlag
+	}
+	return 0
+}
+
+func (x *RedGrayTipsInfo) GetLuckyUin() int64 {
+	if x != nil {
+		return x.LuckyUin
+	}
+	return 0
+}
+
+type GeneralGrayTipInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MsgInfo         []*Sub8AMsgInfo `protobuf:"bytes,1,rep,name=msg_info,json=msgInfo,proto3" json:"msg_info,omitempty"`
-	AppId           int32           `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
-	InstId          int32           `protobuf:"varint,3,opt,name=instId,proto3" json:"instId,omitempty"`
-	LongMessageFlag int32           `protobuf:"varint,4,opt,name=longMessageFlag,proto3" json:"longMessageFlag,omitempty"`
-	Reserved        []byte          `protobuf:"bytes,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	BusiType      int64         `protobuf:"varint,1,opt,name=busiType,proto3" json:"busiType,omitempty"`
+	BusiId        int64         `protobuf:"varint,2,opt,name=busiId,proto3" json:"busiId,omitempty"`
+	CtrlFlag      int32         `protobuf:"varint,3,opt,name=ctrlFlag,proto3" json:"ctrlFlag,omitempty"`
+	C2CType       int32         `protobuf:"varint,4,opt,name=c2cType,proto3" json:"c2cType,omitempty"`
+	ServiceType   int32         `protobuf:"varint,5,opt,name=serviceType,proto3" json:"serviceType,omitempty"`
+	TemplId       int64         `protobuf:"varint,6,opt,name=templId,proto3" json:"templId,omitempty"`
+	MsgTemplParam []*TemplParam `protobuf:"bytes,7,rep,name=msgTemplParam,proto3" json:"msgTemplParam,omitempty"`
+	Content       string        `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *Sub8A) Reset() {
-	*x = Sub8A{}
+func (x *GeneralGrayTipInfo) Reset() {
+	*x = GeneralGrayTipInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_data_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1779,13 +1918,13 @@ func (x *Sub8A) Reset() {
 	}
 }
 
-func (x *Sub8A) String() string {
+func (x *GeneralGrayTipInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sub8A) ProtoMessage() {}
+func (*GeneralGrayTipInfo) ProtoMessage() {}
 
-func (x *Sub8A) ProtoReflect() protoreflect.Message {
+func (x *GeneralGrayTipInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_data_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1797,155 +1936,1512 @@ func (x *Sub8A) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sub8A.ProtoReflect.Descriptor instead.
-func (*Sub8A) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeneralGrayTipInfo.ProtoReflect.Descriptor instead.
+func (*GeneralGrayTipInfo) Descriptor() ([]byte, []int) {
 	return file_data_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Sub8A) GetMsgInfo() []*Sub8AMsgInfo {
+func (x *GeneralGrayTipInfo) GetBusiType() int64 {
 	if x != nil {
-		return x.MsgInfo
+		return x.BusiType
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetBusiId() int64 {
+	if x != nil {
+		return x.BusiId
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetCtrlFlag() int32 {
+	if x != nil {
+		return x.CtrlFlag
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetC2CType() int32 {
+	if x != nil {
+		return x.C2CType
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetServiceType() int32 {
+	if x != nil {
+		return x.ServiceType
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetTemplId() int64 {
+	if x != nil {
+		return x.TemplId
+	}
+	return 0
+}
+
+func (x *GeneralGrayTipInfo) GetMsgTemplParam() []*TemplParam {
+	if x != nil {
+		return x.MsgTemplParam
 	}
 	return nil
 }
 
-func (x *Sub8A) GetAppId() int32 {
+func (x *GeneralGrayTipInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type TemplParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TemplParam) Reset() {
+	*x = TemplParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplParam) ProtoMessage() {}
+
+func (x *TemplParam) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplParam.ProtoReflect.Descriptor instead.
+func (*TemplParam) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TemplParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplParam) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type MessageRecallReminder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin             int64                  `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	Nickname        []byte                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	RecalledMsgList []*RecalledMessageMeta `protobuf:"bytes,3,rep,name=recalledMsgList,proto3" json:"recalledMsgList,omitempty"`
+	ReminderContent []byte                 `protobuf:"bytes,4,opt,name=reminderContent,proto3" json:"reminderContent,omitempty"`
+	Userdef         []byte                 `protobuf:"bytes,5,opt,name=userdef,proto3" json:"userdef,omitempty"`
+	GroupType       int32                  `protobuf:"varint,6,opt,name=groupType,proto3" json:"groupType,omitempty"`
+	OpType          int32                  `protobuf:"varint,7,opt,name=opType,proto3" json:"opType,omitempty"`
+}
+
+func (x *MessageRecallReminder) Reset() {
+	*x = MessageRecallReminder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRecallReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRecallReminder) ProtoMessage() {}
+
+func (x *MessageRecallReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRecallReminder.ProtoReflect.Descriptor instead.
+func (*MessageRecallReminder) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MessageRecallReminder) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *MessageRecallReminder) GetNickname() []byte {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *MessageRecallReminder) GetRecalledMsgList() []*RecalledMessageMeta {
+	if x != nil {
+		return x.RecalledMsgList
+	}
+	return nil
+}
+
+func (x *MessageRecallReminder) GetReminderContent() []byte {
+	if x != nil {
+		return x.ReminderContent
+	}
+	return nil
+}
+
+func (x *MessageRecallReminder) GetUserdef() []byte {
+	if x != nil {
+		return x.Userdef
+	}
+	return nil
+}
+
+func (x *MessageRecallReminder) GetGroupType() int32 {
+	if x != nil {
+		return x.GroupType
+	}
+	return 0
+}
+
+func (x *MessageRecallReminder) GetOpType() int32 {
+	if x != nil {
+		return x.OpType
+	}
+	return 0
+}
+
+type RecalledMessageMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       int32 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time      int32 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	MsgRandom int32 `protobuf:"varint,3,opt,name=msgRandom,proto3" json:"msgRandom,omitempty"`
+	MsgType   int32 `protobuf:"varint,4,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	MsgFlag   int32 `protobuf:"varint,5,opt,name=msgFlag,proto3" json:"msgFlag,omitempty"`
+	AuthorUin int64 `protobuf:"varint,6,opt,name=authorUin,proto3" json:"authorUin,omitempty"`
+}
+
+func (x *RecalledMessageMeta) Reset() {
+	*x = RecalledMessageMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecalledMessageMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalledMessageMeta) ProtoMessage() {}
+
+func (x *RecalledMessageMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalledMessageMeta.ProtoReflect.Descriptor instead.
+func (*RecalledMessageMeta) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RecalledMessageMeta) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RecalledMessageMeta) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *RecalledMessageMeta) GetMsgRandom() int32 {
+	if x != nil {
+		return x.MsgRandom
+	}
+	return 0
+}
+
+func (x *RecalledMessageMeta) GetMsgType() int32 {
+	if x != nil {
+		return x.MsgType
+	}
+	return 0
+}
+
+func (x *RecalledMessageMeta) GetMsgFlag() int32 {
+	if x != nil {
+		return x.MsgFlag
+	}
+	return 0
+}
+
+func (x *RecalledMessageMeta) GetAuthorUin() int64 {
+	if x != nil {
+		return x.AuthorUin
+	}
+	return 0
+}
+
+type SubD4 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin int64 `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+}
+
+func (x *SubD4) Reset() {
+	*x = SubD4{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubD4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubD4) ProtoMessage() {}
+
+func (x *SubD4) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubD4.ProtoReflect.Descriptor instead.
+func (*SubD4) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubD4) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+type Sub27 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModInfos []*Sub27ForwardBody `protobuf:"bytes,1,rep,name=modInfos,proto3" json:"modInfos,omitempty"`
+}
+
+func (x *Sub27) Reset() {
+	*x = Sub27{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sub27) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sub27) ProtoMessage() {}
+
+func (x *Sub27) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sub27.ProtoReflect.Descriptor instead.
+func (*Sub27) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Sub27) GetModInfos() []*Sub27ForwardBody {
+	if x != nil {
+		return x.ModInfos
+	}
+	return nil
+}
+
+type Sub27ForwardBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotifyType               int32                  `protobuf:"varint,1,opt,name=notifyType,proto3" json:"notifyType,omitempty"`
+	OpType                   int32                  `protobuf:"varint,2,opt,name=opType,proto3" json:"opType,omitempty"`
+	MsgModGroupMemberProfile *ModGroupMemberProfile `protobuf:"bytes,13,opt,name=msgModGroupMemberProfile,proto3" json:"msgModGroupMemberProfile,omitempty"`
+}
+
+func (x *Sub27ForwardBody) Reset() {
+	*x = Sub27ForwardBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sub27ForwardBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sub27ForwardBody) ProtoMessage() {}
+
+func (x *Sub27ForwardBody) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sub27ForwardBody.ProtoReflect.Descriptor instead.
+func (*Sub27ForwardBody) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Sub27ForwardBody) GetNotifyType() int32 {
+	if x != nil {
+		return x.NotifyType
+	}
+	return 0
+}
+
+func (x *Sub27ForwardBody) GetOpType() int32 {
+	if x != nil {
+		return x.OpType
+	}
+	return 0
+}
+
+func (x *Sub27ForwardBody) GetMsgModGroupMemberProfile() *ModGroupMemberProfile {
+	if x != nil {
+		return x.MsgModGroupMemberProfile
+	}
+	return nil
+}
+
+type ModGroupMemberProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupUin                   int64                     `protobuf:"varint,1,opt,name=groupUin,proto3" json:"groupUin,omitempty"`
+	Uin                        int64                     `protobuf:"varint,2,opt,name=uin,proto3" json:"uin,omitempty"`
+	MsgGroupMemberProfileInfos []*GroupMemberProfileInfo `protobuf:"bytes,3,rep,name=msgGroupMemberProfileInfos,proto3" json:"msgGroupMemberProfileInfos,omitempty"`
+	GroupCode                  int64                     `protobuf:"varint,4,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+}
+
+func (x *ModGroupMemberProfile) Reset() {
+	*x = ModGroupMemberProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModGroupMemberProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModGroupMemberProfile) ProtoMessage() {}
+
+func (x *ModGroupMemberProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModGroupMemberProfile.ProtoReflect.Descriptor instead.
+func (*ModGroupMemberProfile) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ModGroupMemberProfile) GetGroupUin() int64 {
+	if x != nil {
+		return x.GroupUin
+	}
+	return 0
+}
+
+func (x *ModGroupMemberProfile) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *ModGroupMemberProfile) GetMsgGroupMemberProfileInfos() []*GroupMemberProfileInfo {
+	if x != nil {
+		return x.MsgGroupMemberProfileInfos
+	}
+	return nil
+}
+
+func (x *ModGroupMemberProfile) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+type GroupMemberProfileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field int32  `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GroupMemberProfileInfo) Reset() {
+	*x = GroupMemberProfileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberProfileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberProfileInfo) ProtoMessage() {}
+
+func (x *GroupMemberProfileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberProfileInfo.ProtoReflect.Descriptor instead.
+func (*GroupMemberProfileInfo) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GroupMemberProfileInfo) GetField() int32 {
+	if x != nil {
+		return x.Field
+	}
+	return 0
+}
+
+func (x *GroupMemberProfileInfo) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Sub8A struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgInfo         []*Sub8AMsgInfo `protobuf:"bytes,1,rep,name=msg_info,json=msgInfo,proto3" json:"msg_info,omitempty"`
+	AppId           int32           `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	InstId          int32           `protobuf:"varint,3,opt,name=instId,proto3" json:"instId,omitempty"`
+	LongMessageFlag int32           `protobuf:"varint,4,opt,name=longMessageFlag,proto3" json:"longMessageFlag,omitempty"`
+	Reserved        []byte          `protobuf:"bytes,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+}
+
+func (x *Sub8A) Reset() {
+	*x = Sub8A{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sub8A) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sub8A) ProtoMessage() {}
+
+func (x *Sub8A) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sub8A.ProtoReflect.Descriptor instead.
+func (*Sub8A) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Sub8A) GetMsgInfo() []*Sub8AMsgInfo {
+	if x != nil {
+		return x.MsgInfo
+	}
+	return nil
+}
+
+func (x *Sub8A) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *Sub8A) GetInstId() int32 {
+	if x != nil {
+		return x.InstId
+	}
+	return 0
+}
+
+func (x *Sub8A) GetLongMessageFlag() int32 {
+	if x != nil {
+		return x.LongMessageFlag
+	}
+	return 0
+}
+
+func (x *Sub8A) GetReserved() []byte {
+	if x != nil {
+		return x.Reserved
+	}
+	return nil
+}
+
+type Sub8AMsgInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUin   int64 `protobuf:"varint,1,opt,name=fromUin,proto3" json:"fromUin,omitempty"`
+	ToUin     int64 `protobuf:"varint,2,opt,name=toUin,proto3" json:"toUin,omitempty"`
+	MsgSeq    int32 `protobuf:"varint,3,opt,name=msgSeq,proto3" json:"msgSeq,omitempty"`
+	MsgUid    int64 `protobuf:"varint,4,opt,name=msgUid,proto3" json:"msgUid,omitempty"`
+	MsgTime   int64 `protobuf:"varint,5,opt,name=msgTime,proto3" json:"msgTime,omitempty"`
+	MsgRandom int32 `protobuf:"varint,6,opt,name=msgRandom,proto3" json:"msgRandom,omitempty"`
+	PkgNum    int32 `protobuf:"varint,7,opt,name=pkgNum,proto3" json:"pkgNum,omitempty"`
+	PkgIndex  int32 `protobuf:"varint,8,opt,name=pkgIndex,proto3" json:"pkgIndex,omitempty"`
+	DevSeq    int32 `protobuf:"varint,9,opt,name=devSeq,proto3" json:"devSeq,omitempty"`
+}
+
+func (x *Sub8AMsgInfo) Reset() {
+	*x = Sub8AMsgInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sub8AMsgInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sub8AMsgInfo) ProtoMessage() {}
+
+func (x *Sub8AMsgInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sub8AMsgInfo.ProtoReflect.Descriptor instead.
+func (*Sub8AMsgInfo) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Sub8AMsgInfo) GetFromUin() int64 {
+	if x != nil {
+		return x.FromUin
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetToUin() int64 {
+	if x != nil {
+		return x.ToUin
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetMsgSeq() int32 {
+	if x != nil {
+		return x.MsgSeq
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetMsgUid() int64 {
+	if x != nil {
+		return x.MsgUid
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetMsgTime() int64 {
+	if x != nil {
+		return x.MsgTime
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetMsgRandom() int32 {
+	if x != nil {
+		return x.MsgRandom
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetPkgNum() int32 {
+	if x != nil {
+		return x.PkgNum
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetPkgIndex() int32 {
+	if x != nil {
+		return x.PkgIndex
+	}
+	return 0
+}
+
+func (x *Sub8AMsgInfo) GetDevSeq() int32 {
+	if x != nil {
+		return x.DevSeq
+	}
+	return 0
+}
+
+type AnonymousNickReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd      int32             `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	AnonyReq *AnonymousNickReq `protobuf:"bytes,10,opt,name=anonyReq,proto3" json:"anonyReq,omitempty"`
+}
+
+func (x *AnonymousNickReqBody) Reset() {
+	*x = AnonymousNickReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymousNickReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymousNickReqBody) ProtoMessage() {}
+
+func (x *AnonymousNickReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymousNickReqBody.ProtoReflect.Descriptor instead.
+func (*AnonymousNickReqBody) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AnonymousNickReqBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *AnonymousNickReqBody) GetAnonyReq() *AnonymousNickReq {
+	if x != nil {
+		return x.AnonyReq
+	}
+	return nil
+}
+
+type AnonymousNickReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin       int64 `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	GroupCode int64 `protobuf:"varint,2,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+}
+
+func (x *AnonymousNickReq) Reset() {
+	*x = AnonymousNickReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymousNickReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymousNickReq) ProtoMessage() {}
+
+func (x *AnonymousNickReq) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymousNickReq.ProtoReflect.Descriptor instead.
+func (*AnonymousNickReq) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AnonymousNickReq) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *AnonymousNickReq) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+type AnonymousNickRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd      int32             `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	AnonyRsp *AnonymousNickRsp `protobuf:"bytes,11,opt,name=anonyRsp,proto3" json:"anonyRsp,omitempty"`
+}
+
+func (x *AnonymousNickRspBody) Reset() {
+	*x = AnonymousNickRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymousNickRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymousNickRspBody) ProtoMessage() {}
+
+func (x *AnonymousNickRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymousNickRspBody.ProtoReflect.Descriptor instead.
+func (*AnonymousNickRspBody) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AnonymousNickRspBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *AnonymousNickRspBody) GetAnonyRsp() *AnonymousNickRsp {
+	if x != nil {
+		return x.AnonyRsp
+	}
+	return nil
+}
+
+type AnonymousNickRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret           int32  `protobuf:"varint,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	ErrMsg        []byte `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Uin           int64  `protobuf:"varint,3,opt,name=uin,proto3" json:"uin,omitempty"`
+	AnonyId       []byte `protobuf:"bytes,4,opt,name=anonyId,proto3" json:"anonyId,omitempty"`
+	AnonyNick     []byte `protobuf:"bytes,5,opt,name=anonyNick,proto3" json:"anonyNick,omitempty"`
+	PortraitIndex int32  `protobuf:"varint,6,opt,name=portraitIndex,proto3" json:"portraitIndex,omitempty"`
+	BubbleIndex   int32  `protobuf:"varint,7,opt,name=bubbleIndex,proto3" json:"bubbleIndex,omitempty"`
+	ExpiredTime   int32  `protobuf:"varint,8,opt,name=expiredTime,proto3" json:"expiredTime,omitempty"`
+	Color         []byte `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *AnonymousNickRsp) Reset() {
+	*x = AnonymousNickRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymousNickRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymousNickRsp) ProtoMessage() {}
+
+func (x *AnonymousNickRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymousNickRsp.ProtoReflect.Descriptor instead.
+func (*AnonymousNickRsp) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AnonymousNickRsp) GetRet() int32 {
+	if x != nil {
+		return x.Ret
+	}
+	return 0
+}
+
+func (x *AnonymousNickRsp) GetErrMsg() []byte {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return nil
+}
+
+func (x *AnonymousNickRsp) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *AnonymousNickRsp) GetAnonyId() []byte {
+	if x != nil {
+		return x.AnonyId
+	}
+	return nil
+}
+
+func (x *AnonymousNickRsp) GetAnonyNick() []byte {
+	if x != nil {
+		return x.AnonyNick
+	}
+	return nil
+}
+
+func (x *AnonymousNickRsp) GetPortraitIndex() int32 {
+	if x != nil {
+		return x.PortraitIndex
+	}
+	return 0
+}
+
+func (x *AnonymousNickRsp) GetBubbleIndex() int32 {
+	if x != nil {
+		return x.BubbleIndex
+	}
+	return 0
+}
+
+func (x *AnonymousNickRsp) GetExpiredTime() int32 {
+	if x != nil {
+		return x.ExpiredTime
+	}
+	return 0
+}
+
+func (x *AnonymousNickRsp) GetColor() []byte {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+type GroupMemberReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode       int64 `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	Uin             int64 `protobuf:"varint,2,opt,name=uin,proto3" json:"uin,omitempty"`
+	NewClient       bool  `protobuf:"varint,3,opt,name=newClient,proto3" json:"newClient,omitempty"`
+	ClientType      int32 `protobuf:"varint,4,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	RichCardNameVer int32 `protobuf:"varint,5,opt,name=richCardNameVer,proto3" json:"richCardNameVer,omitempty"`
+}
+
+func (x *GroupMemberReqBody) Reset() {
+	*x = GroupMemberReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberReqBody) ProtoMessage() {}
+
+func (x *GroupMemberReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberReqBody.ProtoReflect.Descriptor instead.
+func (*GroupMemberReqBody) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GroupMemberReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *GroupMemberReqBody) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *GroupMemberReqBody) GetNewClient() bool {
+	if x != nil {
+		return x.NewClient
+	}
+	return false
+}
+
+func (x *GroupMemberReqBody) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *GroupMemberReqBody) GetRichCardNameVer() int32 {
+	if x != nil {
+		return x.RichCardNameVer
+	}
+	return 0
+}
+
+type GroupMemberRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode int64            `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	SelfRole  int32            `protobuf:"varint,2,opt,name=selfRole,proto3" json:"selfRole,omitempty"`
+	MemInfo   *GroupMemberInfo `protobuf:"bytes,3,opt,name=memInfo,proto3" json:"memInfo,omitempty"`
+}
+
+func (x *GroupMemberRspBody) Reset() {
+	*x = GroupMemberRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberRspBody) ProtoMessage() {}
+
+func (x *GroupMemberRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberRspBody.ProtoReflect.Descriptor instead.
+func (*GroupMemberRspBody) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GroupMemberRspBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *GroupMemberRspBody) GetSelfRole() int32 {
+	if x != nil {
+		return x.SelfRole
+	}
+	return 0
+}
+
+func (x *GroupMemberRspBody) GetMemInfo() *GroupMemberInfo {
+	if x != nil {
+		return x.MemInfo
+	}
+	return nil
+}
+
+type GroupMemberInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin                    int64  `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	Result                 int32  `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
+	Errmsg                 []byte `protobuf:"bytes,3,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	IsFriend               bool   `protobuf:"varint,4,opt,name=isFriend,proto3" json:"isFriend,omitempty"`
+	Remark                 []byte `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
+	IsConcerned            bool   `protobuf:"varint,6,opt,name=isConcerned,proto3" json:"isConcerned,omitempty"`
+	Credit                 int32  `protobuf:"varint,7,opt,name=credit,proto3" json:"credit,omitempty"`
+	Card                   []byte `protobuf:"bytes,8,opt,name=card,proto3" json:"card,omitempty"`
+	Sex                    int32  `protobuf:"varint,9,opt,name=sex,proto3" json:"sex,omitempty"`
+	Location               []byte `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	Nick                   []byte `protobuf:"bytes,11,opt,name=nick,proto3" json:"nick,omitempty"`
+	Age                    int32  `protobuf:"varint,12,opt,name=age,proto3" json:"age,omitempty"`
+	Lev                    []byte `protobuf:"bytes,13,opt,name=lev,proto3" json:"lev,omitempty"`
+	Join                   int64  `protobuf:"varint,14,opt,name=join,proto3" json:"join,omitempty"`
+	LastSpeak              int64  `protobuf:"varint,15,opt,name=lastSpeak,proto3" json:"lastSpeak,omitempty"`
+	GbarTitle              []byte `protobuf:"bytes,18,opt,name=gbarTitle,proto3" json:"gbarTitle,omitempty"`
+	GbarUrl                []byte `protobuf:"bytes,19,opt,name=gbarUrl,proto3" json:"gbarUrl,omitempty"`
+	GbarCnt                int32  `protobuf:"varint,20,opt,name=gbarCnt,proto3" json:"gbarCnt,omitempty"`
+	IsAllowModCard         bool   `protobuf:"varint,21,opt,name=isAllowModCard,proto3" json:"isAllowModCard,omitempty"`
+	IsVip                  bool   `protobuf:"varint,22,opt,name=isVip,proto3" json:"isVip,omitempty"`
+	IsYearVip              bool   `protobuf:"varint,23,opt,name=isYearVip,proto3" json:"isYearVip,omitempty"`
+	IsSuperVip             bool   `protobuf:"varint,24,opt,name=isSuperVip,proto3" json:"isSuperVip,omitempty"`
+	IsSuperQq              bool   `protobuf:"varint,25,opt,name=isSuperQq,proto3" json:"isSuperQq,omitempty"`
+	VipLev                 int32  `protobuf:"varint,26,opt,name=vipLev,proto3" json:"vipLev,omitempty"`
+	Role                   int32  `protobuf:"varint,27,opt,name=role,proto3" json:"role,omitempty"`
+	LocationShared         bool   `protobuf:"varint,28,opt,name=locationShared,proto3" json:"locationShared,omitempty"`
+	Int64Distance          int64  `protobuf:"varint,29,opt,name=int64Distance,proto3" json:"int64Distance,omitempty"`
+	ConcernType            int32  `protobuf:"varint,30,opt,name=concernType,proto3" json:"concernType,omitempty"`
+	SpecialTitle           []byte `protobuf:"bytes,31,opt,name=specialTitle,proto3" json:"specialTitle,omitempty"`
+	SpecialTitleExpireTime int32  `protobuf:"varint,32,opt,name=specialTitleExpireTime,proto3" json:"specialTitleExpireTime,omitempty"`
+	PhoneNum               []byte `protobuf:"bytes,35,opt,name=phoneNum,proto3" json:"phoneNum,omitempty"`
+	Job                    []byte `protobuf:"bytes,36,opt,name=job,proto3" json:"job,omitempty"`
+	MedalId                int32  `protobuf:"varint,37,opt,name=medalId,proto3" json:"medalId,omitempty"`
+	Level                  int32  `protobuf:"varint,39,opt,name=level,proto3" json:"level,omitempty"`
+	Honor                  string `protobuf:"bytes,41,opt,name=honor,proto3" json:"honor,omitempty"`
+}
+
+func (x *GroupMemberInfo) Reset() {
+	*x = GroupMemberInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_data_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberInfo) ProtoMessage() {}
+
+func (x *GroupMemberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_data_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberInfo.ProtoReflect.Descriptor instead.
+func (*GroupMemberInfo) Descriptor() ([]byte, []int) {
+	return file_data_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GroupMemberInfo) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetErrmsg() []byte {
+	if x != nil {
+		return x.Errmsg
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetIsFriend() bool {
+	if x != nil {
+		return x.IsFriend
+	}
+	return false
+}
+
+func (x *GroupMemberInfo) GetRemark() []byte {
+	if x != nil {
+		return x.Remark
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetIsConcerned() bool {
+	if x != nil {
+		return x.IsConcerned
+	}
+	return false
+}
+
+func (x *GroupMemberInfo) GetCredit() int32 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetCard() []byte {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetSex() int32 {
+	if x != nil {
+		return x.Sex
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetLocation() []byte {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetNick() []byte {
+	if x != nil {
+		return x.Nick
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetLev() []byte {
+	if x != nil {
+		return x.Lev
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetJoin() int64 {
+	if x != nil {
+		return x.Join
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetLastSpeak() int64 {
+	if x != nil {
+		return x.LastSpeak
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetGbarTitle() []byte {
+	if x != nil {
+		return x.GbarTitle
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetGbarUrl() []byte {
+	if x != nil {
+		return x.GbarUrl
+	}
+	return nil
+}
+
+func (x *GroupMemberInfo) GetGbarCnt() int32 {
 	if x != nil {
-		return x.AppId
+		return x.GbarCnt
 	}
 	return 0
 }
 
-func (x *Sub8A) GetInstId() int32 {
+func (x *GroupMemberInfo) GetIsAllowModCard() bool {
 	if x != nil {
-		return x.InstId
+		return x.IsAllowModCard
 	}
-	return 0
+	return false
 }
 
-func (x *Sub8A) GetLongMessageFlag() int32 {
+func (x *GroupMemberInfo) GetIsVip() bool {
 	if x != nil {
-		return x.LongMessageFlag
+		return x.IsVip
 	}
-	return 0
+	return false
 }
 
-func (x *Sub8A) GetReserved() []byte {
+func (x *GroupMemberInfo) GetIsYearVip() bool {
 	if x != nil {
-		return x.Reserved
+		return x.IsYearVip
 	}
-	return nil
+	return false
 }
 
-type Sub8AMsgInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FromUin   int64 `protobuf:"varint,1,opt,name=fromUin,proto3" json:"fromUin,omitempty"`
-	ToUin     int64 `protobuf:"varint,2,opt,name=toUin,proto3" json:"toUin,omitempty"`
-	MsgSeq    int32 `protobuf:"varint,3,opt,name=msgSeq,proto3" json:"msgSeq,omitempty"`
-	MsgUid    int64 `protobuf:"varint,4,opt,name=msgUid,proto3" json:"msgUid,omitempty"`
-	MsgTime   int64 `protobuf:"varint,5,opt,name=msgTime,proto3" json:"msgTime,omitempty"`
-	MsgRandom int32 `protobuf:"varint,6,opt,name=msgRandom,proto3" json:"msgRandom,omitempty"`
-	PkgNum    int32 `protobuf:"varint,7,opt,name=pkgNum,proto3" json:"pkgNum,omitempty"`
-	PkgIndex  int32 `protobuf:"varint,8,opt,name=pkgIndex,proto3" json:"pkgIndex,omitempty"`
-	DevSeq    int32 `protobuf:"varint,9,opt,name=devSeq,proto3" json:"devSeq,omitempty"`
+func (x *GroupMemberInfo) GetIsSuperVip() bool {
+	if x != nil {
+		return x.IsSuperVip
+	}
+	return false
 }
 
-func (x *Sub8AMsgInfo) Reset() {
-	*x = Sub8AMsgInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_data_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GroupMemberInfo) GetIsSuperQq() bool {
+	if x != nil {
+		return x.IsSuperQq
 	}
+	return false
 }
 
-func (x *Sub8AMsgInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GroupMemberInfo) GetVipLev() int32 {
+	if x != nil {
+		return x.VipLev
+	}
+	return 0
 }
 
-func (*Sub8AMsgInfo) ProtoMessage() {}
-
-func (x *Sub8AMsgInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_data_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GroupMemberInfo) GetRole() int32 {
+	if x != nil {
+		return x.Role
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Sub8AMsgInfo.ProtoReflect.Descriptor instead.
-func (*Sub8AMsgInfo) Descriptor() ([]byte, []int) {
-	return file_data_proto_rawDescGZIP(), []int{20}
+func (x *GroupMemberInfo) GetLocationShared() bool {
+	if x != nil {
+		return x.LocationShared
+	}
+	return false
 }
 
-func (x *Sub8AMsgInfo) GetFromUin() int64 {
+func (x *GroupMemberInfo) GetInt64Distance() int64 {
 	if x != nil {
-		return x.FromUin
+		return x.Int64Distance
 	}
 	return 0
 }
 
-func (x *Sub8AMsgInfo) GetToUin() int64 {
+func (x *GroupMemberInfo) GetConcernType() int32 {
 	if x != nil {
-		return x.ToUin
+		return x.ConcernType
 	}
 	return 0
 }
 
-func (x *Sub8AMsgInfo) GetMsgSeq() int32 {
+func (x *GroupMemberInfo) GetSpecialTitle() []byte {
 	if x != nil {
-		return x.MsgSeq
+		return x.SpecialTitle
 	}
-	return 0
+	return nil
 }
 
-func (x *Sub8AMsgInfo) GetMsgUid() int64 {
+func (x *GroupMemberInfo) GetSpecialTitleExpireTime() int32 {
 	if x != nil {
-		return x.MsgUid
+		return x.SpecialTitleExpireTime
 	}
 	return 0
 }
 
-func (x *Sub8AMsgInfo) GetMsgTime() int64 {
+func (x *GroupMemberInfo) GetPhoneNum() []byte {
 	if x != nil {
-		return x.MsgTime
+		return x.PhoneNum
 	}
-	return 0
+	return nil
 }
 
-func (x *Sub8AMsgInfo) GetMsgRandom() int32 {
+func (x *GroupMemberInfo) GetJob() []byte {
 	if x != nil {
-		return x.MsgRandom
+		return x.Job
 	}
-	return 0
+	return nil
 }
 
-func (x *Sub8AMsgInfo) GetPkgNum() int32 {
+func (x *GroupMemberInfo) GetMedalId() int32 {
 	if x != nil {
-		return x.PkgNum
+		return x.MedalId
 	}
 	return 0
 }
 
-func (x *Sub8AMsgInfo) GetPkgIndex() int32 {
+func (x *GroupMemberInfo) GetLevel() int32 {
 	if x != nil {
-		return x.PkgIndex
+		return x.Level
 	}
 	return 0
 }
 
-func (x *Sub8AMsgInfo) GetDevSeq() int32 {
+func (x *GroupMemberInfo) GetHonor() string {
 	if x != nil {
-		return x.DevSeq
+		return x.Honor
 	}
-	return 0
+	return ""
 }
 
 var File_data_proto protoreflect.FileDescriptor
@@ -2174,14 +3670,104 @@ var file_data_proto_rawDesc = []byte{
 	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6d,
 	0x73, 0x67, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x73, 0x67,
 	0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x6d, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d,
-	0x73, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x6d, 0x69,
-	0x6e, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x61,
-	0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xe1, 0x02, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x4d, 0x73, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x4d, 0x73,
+	0x67, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x41, 0x49, 0x4f, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70,
+	0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x64, 0x54, 0x69,
+	0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x64, 0x47, 0x72,
+	0x61, 0x79, 0x54, 0x69, 0x70, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x70, 0x74,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x79,
+	0x54, 0x69, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x6f, 0x70, 0x74, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70, 0x12, 0x3d, 0x0a, 0x10, 0x71, 0x71,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x51, 0x51, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x10, 0x71, 0x71, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x22, 0xbe, 0x02, 0x0a, 0x10, 0x51, 0x51,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x1c,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x41,
+	0x49, 0x4f, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69, 0x70, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
+	0x6c, 0x69, 0x61, 0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f,
+	0x70, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x64, 0x47, 0x72, 0x61, 0x79, 0x54, 0x69,
+	0x70, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x4c, 0x61,
+	0x73, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x68, 0x6f,
+	0x77, 0x4c, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x52, 0x69, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x69, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x69, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x69, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x11, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x75, 0x63, 0x6b, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6c, 0x75, 0x63, 0x6b, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x69,
+	0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x69,
+	0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x75, 0x63, 0x6b, 0x79, 0x55,
+	0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x75, 0x63, 0x6b, 0x79, 0x55,
+	0x69, 0x6e, 0x22, 0x87, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x47, 0x72,
+	0x61, 0x79, 0x54, 0x69, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x73,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x75, 0x73,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x73, 0x69, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x73, 0x69, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x74, 0x72, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x74, 0x72, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x32, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x32, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x52, 0x0d, 0x6d, 0x73, 0x67, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x0a,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10,
 	0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e,
 	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -2210,32 +3796,173 @@ var file_data_proto_rawDesc = []byte{
 	0x55, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x55, 0x69, 0x6e, 0x22, 0x19, 0x0a, 0x05, 0x53, 0x75, 0x62, 0x44, 0x34, 0x12, 0x10, 0x0a,
 	0x03, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x22,
-	0xa5, 0x01, 0x0a, 0x05, 0x53, 0x75, 0x62, 0x38, 0x41, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x73, 0x67,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x75,
-	0x62, 0x38, 0x41, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x73,
-	0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x46, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x38,
-	0x41, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d,
-	0x55, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55,
-	0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x53,
-	0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6d, 0x73, 0x67, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6b, 0x67, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x70, 0x6b, 0x67, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6b, 0x67, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6b, 0x67, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x53, 0x65, 0x71, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x65, 0x76, 0x53, 0x65, 0x71, 0x42, 0x06, 0x5a, 0x04,
+	0x36, 0x0a, 0x05, 0x53, 0x75, 0x62, 0x32, 0x37, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x75, 0x62,
+	0x32, 0x37, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x6d,
+	0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x32,
+	0x37, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x52, 0x0a, 0x18, 0x6d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x18,
+	0x6d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e,
+	0x12, 0x57, 0x0a, 0x1a, 0x6d, 0x73, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x1a, 0x6d,
+	0x73, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x44, 0x0a, 0x16, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x01,
+	0x0a, 0x05, 0x53, 0x75, 0x62, 0x38, 0x41, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x75, 0x62, 0x38,
+	0x41, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x74, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x38, 0x41, 0x4d,
+	0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x69, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x73, 0x67, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x73, 0x67, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6b, 0x67, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6b, 0x67, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6b, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6b, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x53, 0x65, 0x71, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x65, 0x76, 0x53, 0x65, 0x71, 0x22, 0x57, 0x0a, 0x14, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x63, 0x6d, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x52, 0x65, 0x71,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f,
+	0x75, 0x73, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x52, 0x65, 0x71, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x57, 0x0a, 0x14, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x6f, 0x75, 0x73, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6d,
+	0x64, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x52, 0x73, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x4e,
+	0x69, 0x63, 0x6b, 0x52, 0x73, 0x70, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x52, 0x73, 0x70,
+	0x22, 0x86, 0x02, 0x0a, 0x10, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x4e, 0x69,
+	0x63, 0x6b, 0x52, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x6f, 0x72,
+	0x74, 0x72, 0x61, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x72, 0x61, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x75, 0x62, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x72, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc5, 0x07, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x72, 0x6e, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69,
+	0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x76, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6c,
+	0x65, 0x76, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x70,
+	0x65, 0x61, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x70, 0x65, 0x61, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x62, 0x61, 0x72, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x62, 0x61, 0x72, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x62, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x62, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x62, 0x61, 0x72, 0x43, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67,
+	0x62, 0x61, 0x72, 0x43, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4d, 0x6f, 0x64, 0x43, 0x61, 0x72, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
+	0x73, 0x56, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x59, 0x65, 0x61, 0x72, 0x56, 0x69,
+	0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x59, 0x65, 0x61, 0x72, 0x56,
+	0x69, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x56, 0x69, 0x70,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x56,
+	0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x51, 0x71, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x51, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x76, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x63, 0x65, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x36, 0x0a, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x64, 0x61, 0x6c, 0x49,
+	0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x27, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x6e, 0x6f, 0x72, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x6e, 0x6f, 0x72, 0x42, 0x06, 0x5a, 0x04,
 	0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -2251,29 +3978,45 @@ func file_data_proto_rawDescGZIP() []byte {
 	return file_data_proto_rawDescData
 }
 
-var file_data_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_data_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_data_proto_goTypes = []interface{}{
-	(*DeviceInfo)(nil),            // 0: DeviceInfo
-	(*RequestBody)(nil),           // 1: RequestBody
-	(*ConfigSeq)(nil),             // 2: ConfigSeq
-	(*D50ReqBody)(nil),            // 3: D50ReqBody
-	(*D388ReqBody)(nil),           // 4: D388ReqBody
-	(*D388RespBody)(nil),          // 5: D388RespBody
-	(*ReqDataHighwayHead)(nil),    // 6: ReqDataHighwayHead
-	(*RspDataHighwayHead)(nil),    // 7: RspDataHighwayHead
-	(*DataHighwayHead)(nil),       // 8: DataHighwayHead
-	(*SegHead)(nil),               // 9: SegHead
-	(*TryUpImgReq)(nil),           // 10: TryUpImgReq
-	(*TryUpImgResp)(nil),          // 11: TryUpImgResp
-	(*ImgInfo)(nil),               // 12: ImgInfo
-	(*DeleteMessageRequest)(nil),  // 13: DeleteMessageRequest
-	(*MessageItem)(nil),           // 14: MessageItem
-	(*NotifyMsgBody)(nil),         // 15: NotifyMsgBody
-	(*MessageRecallReminder)(nil), // 16: MessageRecallReminder
-	(*RecalledMessageMeta)(nil),   // 17: RecalledMessageMeta
-	(*SubD4)(nil),                 // 18: SubD4
-	(*Sub8A)(nil),                 // 19: Sub8A
-	(*Sub8AMsgInfo)(nil),          // 20: Sub8AMsgInfo
+	(*DeviceInfo)(nil),             // 0: DeviceInfo
+	(*RequestBody)(nil),            // 1: RequestBody
+	(*ConfigSeq)(nil),              // 2: ConfigSeq
+	(*D50ReqBody)(nil),             // 3: D50ReqBody
+	(*D388ReqBody)(nil),            // 4: D388ReqBody
+	(*D388RespBody)(nil),           // 5: D388RespBody
+	(*ReqDataHighwayHead)(nil),     // 6: ReqDataHighwayHead
+	(*RspDataHighwayHead)(nil),     // 7: RspDataHighwayHead
+	(*DataHighwayHead)(nil),        // 8: DataHighwayHead
+	(*SegHead)(nil),                // 9: SegHead
+	(*TryUpImgReq)(nil),            // 10: TryUpImgReq
+	(*TryUpImgResp)(nil),           // 11: TryUpImgResp
+	(*ImgInfo)(nil),                // 12: ImgInfo
+	(*DeleteMessageRequest)(nil),   // 13: DeleteMessageRequest
+	(*MessageItem)(nil),            // 14: MessageItem
+	(*NotifyMsgBody)(nil),          // 15: NotifyMsgBody
+	(*QQGroupDigestMsg)(nil),       // 16: QQGroupDigestMsg
+	(*AIOGrayTipsInfo)(nil),        // 17: AIOGrayTipsInfo
+	(*RedGrayTipsInfo)(nil),        // 18: RedGrayTipsInfo
+	(*GeneralGrayTipInfo)(nil),     // 19: GeneralGrayTipInfo
+	(*TemplParam)(nil),             // 20: TemplParam
+	(*MessageRecallReminder)(nil),  // 21: MessageRecallReminder
+	(*RecalledMessageMeta)(nil),    // 22: RecalledMessageMeta
+	(*SubD4)(nil),                  // 23: SubD4
+	(*Sub27)(nil),                  // 24: Sub27
+	(*Sub27ForwardBody)(nil),       // 25: Sub27ForwardBody
+	(*ModGroupMemberProfile)(nil),  // 26: ModGroupMemberProfile
+	(*GroupMemberProfileInfo)(nil), // 27: GroupMemberProfileInfo
+	(*Sub8A)(nil),                  // 28: Sub8A
+	(*Sub8AMsgInfo)(nil),           // 29: Sub8AMsgInfo
+	(*AnonymousNickReqBody)(nil),   // 30: AnonymousNickReqBody
+	(*AnonymousNickReq)(nil),       // 31: AnonymousNickReq
+	(*AnonymousNickRspBody)(nil),   // 32: AnonymousNickRspBody
+	(*AnonymousNickRsp)(nil),       // 33: AnonymousNickRsp
+	(*GroupMemberReqBody)(nil),     // 34: GroupMemberReqBody
+	(*GroupMemberRspBody)(nil),     // 35: GroupMemberRspBody
+	(*GroupMemberInfo)(nil),        // 36: GroupMemberInfo
 }
 var file_data_proto_depIdxs = []int32{
 	2,  // 0: RequestBody.rpt_config_list:type_name -> ConfigSeq
@@ -2285,14 +4028,25 @@ var file_data_proto_depIdxs = []int32{
 	9,  // 6: RspDataHighwayHead.msgSeghead:type_name -> SegHead
 	12, // 7: TryUpImgResp.msgImgInfo:type_name -> ImgInfo
 	14, // 8: DeleteMessageRequest.items:type_name -> MessageItem
-	16, // 9: NotifyMsgBody.optMsgRecall:type_name -> MessageRecallReminder
-	17, // 10: MessageRecallReminder.recalledMsgList:type_name -> RecalledMessageMeta
-	20, // 11: Sub8A.msg_info:type_name -> Sub8AMsgInfo
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	17, // 9: NotifyMsgBody.optMsgGrayTips:type_name -> AIOGrayTipsInfo
+	18, // 10: NotifyMsgBody.optMsgRedTips:type_name -> RedGrayTipsInfo
+	21, // 11: NotifyMsgBody.optMsgRecall:type_name -> MessageRecallReminder
+	19, // 12: NotifyMsgBody.optGeneralGrayTip:type_name -> GeneralGrayTipInfo
+	16, // 13: NotifyMsgBody.qqGroupDigestMsg:type_name -> QQGroupDigestMsg
+	20, // 14: GeneralGrayTipInfo.msgTemplParam:type_name -> TemplParam
+	22, // 15: MessageRecallReminder.recalledMsgList:type_name -> RecalledMessageMeta
+	25, // 16: Sub27.modInfos:type_name -> Sub27ForwardBody
+	26, // 17: Sub27ForwardBody.msgModGroupMemberProfile:type_name -> ModGroupMemberProfile
+	27, // 18: ModGroupMemberProfile.msgGroupMemberProfileInfos:type_name -> GroupMemberProfileInfo
+	29, // 19: Sub8A.msg_info:type_name -> Sub8AMsgInfo
+	31, // 20: AnonymousNickReqBody.anonyReq:type_name -> AnonymousNickReq
+	33, // 21: AnonymousNickRspBody.anonyRsp:type_name -> AnonymousNickRsp
+	36, // 22: GroupMemberRspBody.memInfo:type_name -> GroupMemberInfo
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_data_proto_init() }
@@ -2494,7 +4248,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageRecallReminder); i {
+			switch v := v.(*QQGroupDigestMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2506,7 +4260,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecalledMessageMeta); i {
+			switch v := v.(*AIOGrayTipsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2518,7 +4272,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubD4); i {
+			switch v := v.(*RedGrayTipsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2530,7 +4284,7 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sub8A); i {
+			switch v := v.(*GeneralGrayTipInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2542,6 +4296,114 @@ func file_data_proto_init() {
 			}
 		}
 		file_data_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageRecallReminder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecalledMessageMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubD4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sub27); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sub27ForwardBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModGroupMemberProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberProfileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sub8A); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Sub8AMsgInfo); i {
 			case 0:
 				return &v.state
@@ -2553,6 +4415,90 @@ func file_data_proto_init() {
 				return nil
 			}
 		}
+		file_data_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonymousNickReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonymousNickReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonymousNickRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonymousNickRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_data_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2560,7 +4506,7 @@ func file_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_data_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -577,28 +577,32 @@ type D89AGroupinfo struct {
 	AddOption              int32                       `protobuf:"varint,16,opt,name=addOption,proto3" json:"addOption,omitempty"`
 	// Types that are assignable to ShutupTime:
 	//	*D89AGroupinfo_Val
-	ShutupTime           isD89AGroupinfo_ShutupTime `protobuf_oneof:"shutupTime"`
-	GroupTypeFlag        int32                      `protobuf:"varint,18,opt,name=groupTypeFlag,proto3" json:"groupTypeFlag,omitempty"`
-	StringGroupTag       []byte                     `protobuf:"bytes,19,opt,name=stringGroupTag,proto3" json:"stringGroupTag,omitempty"`
-	MsgGroupGeoInfo      *D89AGroupGeoInfo          `protobuf:"bytes,20,opt,name=msgGroupGeoInfo,proto3" json:"msgGroupGeoInfo,omitempty"`
-	GroupClassExt        int32                      `protobuf:"varint,21,opt,name=groupClassExt,proto3" json:"groupClassExt,omitempty"`
-	IngGroupClassText    []byte                     `protobuf:"bytes,22,opt,name=ingGroupClassText,proto3" json:"ingGroupClassText,omitempty"`
-	AppPrivilegeFlag     int32                      `protobuf:"varint,23,opt,name=appPrivilegeFlag,proto3" json:"appPrivilegeFlag,omitempty"`
-	AppPrivilegeMask     int32                      `protobuf:"varint,24,opt,name=appPrivilegeMask,proto3" json:"appPrivilegeMask,omitempty"`
-	StGroupExInfo        *D89AGroupExInfoOnly       `protobuf:"bytes,25,opt,name=stGroupExInfo,proto3" json:"stGroupExInfo,omitempty"`
-	GroupSecLevel        int32                      `protobuf:"varint,26,opt,name=groupSecLevel,proto3" json:"groupSecLevel,omitempty"`
-	GroupSecLevelInfo    int32                      `protobuf:"varint,27,opt,name=groupSecLevelInfo,proto3" json:"groupSecLevelInfo,omitempty"`
-	SubscriptionUin      int64                      `protobuf:"varint,28,opt,name=subscriptionUin,proto3" json:"subscriptionUin,omitempty"`
-	AllowMemberInvite    int32                      `protobuf:"varint,29,opt,name=allowMemberInvite,proto3" json:"allowMemberInvite,omitempty"`
-	IngGroupQuestion     []byte                     `protobuf:"bytes,30,opt,name=ingGroupQuestion,proto3" json:"ingGroupQuestion,omitempty"`
-	IngGroupAnswer       []byte                     `protobuf:"bytes,31,opt,name=ingGroupAnswer,proto3" json:"ingGroupAnswer,omitempty"`
-	GroupFlagext3        int32                      `protobuf:"varint,32,opt,name=groupFlagext3,proto3" json:"groupFlagext3,omitempty"`
-	GroupFlagext3Mask    int32                      `protobuf:"varint,33,opt,name=groupFlagext3Mask,proto3" json:"groupFlagext3Mask,omitempty"`
-	GroupOpenAppid       int32                      `protobuf:"varint,34,opt,name=groupOpenAppid,proto3" json:"groupOpenAppid,omitempty"`
-	NoFingerOpenFlag     int32                      `protobuf:"varint,35,opt,name=noFingerOpenFlag,proto3" json:"noFingerOpenFlag,omitempty"`
-	NoCodeFingerOpenFlag int32                      `protobuf:"varint,36,opt,name=noCodeFingerOpenFlag,proto3" json:"noCodeFingerOpenFlag,omitempty"`
-	RootId               int64                      `protobuf:"varint,37,opt,name=rootId,proto3" json:"rootId,omitempty"`
-	MsgLimitFrequency    int32                      `protobuf:"varint,38,opt,name=msgLimitFrequency,proto3" json:"msgLimitFrequency,omitempty"`
+	ShutupTime        isD89AGroupinfo_ShutupTime `protobuf_oneof:"shutupTime"`
+	GroupTypeFlag     int32                      `protobuf:"varint,18,opt,name=groupTypeFlag,proto3" json:"groupTypeFlag,omitempty"`
+	StringGroupTag    []byte                     `protobuf:"bytes,19,opt,name=stringGroupTag,proto3" json:"stringGroupTag,omitempty"`
+	MsgGroupGeoInfo   *D89AGroupGeoInfo          `protobuf:"bytes,20,opt,name=msgGroupGeoInfo,proto3" json:"msgGroupGeoInfo,omitempty"`
+	GroupClassExt     int32                      `protobuf:"varint,21,opt,name=groupClassExt,proto3" json:"groupClassExt,omitempty"`
+	IngGroupClassText []byte                     `protobuf:"bytes,22,opt,name=ingGroupClassText,proto3" json:"ingGroupClassText,omitempty"`
+	// Types that are assignable to AppPrivilegeFlag:
+	//	*D89AGroupinfo_AppPrivilegeFlagVal
+	AppPrivilegeFlag  isD89AGroupinfo_AppPrivilegeFlag `protobuf_oneof:"appPrivilegeFlag"`
+	AppPrivilegeMask  int32                            `protobuf:"varint,24,opt,name=appPrivilegeMask,proto3" json:"appPrivilegeMask,omitempty"`
+	StGroupExInfo     *D89AGroupExInfoOnly             `protobuf:"bytes,25,opt,name=stGroupExInfo,proto3" json:"stGroupExInfo,omitempty"`
+	GroupSecLevel     int32                            `protobuf:"varint,26,opt,name=groupSecLevel,proto3" json:"groupSecLevel,omitempty"`
+	GroupSecLevelInfo int32                            `protobuf:"varint,27,opt,name=groupSecLevelInfo,proto3" json:"groupSecLevelInfo,omitempty"`
+	SubscriptionUin   int64                            `protobuf:"varint,28,opt,name=subscriptionUin,proto3" json:"subscriptionUin,omitempty"`
+	// Types that are assignable to AllowMemberInvite:
+	//	*D89AGroupinfo_AllowMemberInviteVal
+	AllowMemberInvite    isD89AGroupinfo_AllowMemberInvite `protobuf_oneof:"allowMemberInvite"`
+	IngGroupQuestion     []byte                            `protobuf:"bytes,30,opt,name=ingGroupQuestion,proto3" json:"ingGroupQuestion,omitempty"`
+	IngGroupAnswer       []byte                            `protobuf:"bytes,31,opt,name=ingGroupAnswer,proto3" json:"ingGroupAnswer,omitempty"`
+	GroupFlagext3        int32                             `protobuf:"varint,32,opt,name=groupFlagext3,proto3" json:"groupFlagext3,omitempty"`
+	GroupFlagext3Mask    int32                             `protobuf:"varint,33,opt,name=groupFlagext3Mask,proto3" json:"groupFlagext3Mask,omitempty"`
+	GroupOpenAppid       int32                             `protobuf:"varint,34,opt,name=groupOpenAppid,proto3" json:"groupOpenAppid,omitempty"`
+	NoFingerOpenFlag     int32                             `protobuf:"varint,35,opt,name=noFingerOpenFlag,proto3" json:"noFingerOpenFlag,omitempty"`
+	NoCodeFingerOpenFlag int32                             `protobuf:"varint,36,opt,name=noCodeFingerOpenFlag,proto3" json:"noCodeFingerOpenFlag,omitempty"`
+	RootId               int64                             `protobuf:"varint,37,opt,name=rootId,proto3" json:"rootId,omitempty"`
+	MsgLimitFrequency    int32                             `protobuf:"varint,38,opt,name=msgLimitFrequency,proto3" json:"msgLimitFrequency,omitempty"`
 }
 
 func (x *D89AGroupinfo) Reset() {
@@ -794,9 +798,16 @@ func (x *D89AGroupinfo) GetIngGroupClassText() []byte {
 	return nil
 }
 
-func (x *D89AGroupinfo) GetAppPrivilegeFlag() int32 {
-	if x != nil {
-		return x.AppPrivilegeFlag
+func (m *D89AGroupinfo) GetAppPrivilegeFlag() isD89AGroupinfo_AppPrivilegeFlag {
+	if m != nil {
+		return m.AppPrivilegeFlag
+	}
+	return nil
+}
+
+func (x *D89AGroupinfo) GetAppPrivilegeFlagVal() int32 {
+	if x, ok := x.GetAppPrivilegeFlag().(*D89AGroupinfo_AppPrivilegeFlagVal); ok {
+		return x.AppPrivilegeFlagVal
 	}
 	return 0
 }
@@ -836,9 +847,16 @@ func (x *D89AGroupinfo) GetSubscriptionUin() int64 {
 	return 0
 }
 
-func (x *D89AGroupinfo) GetAllowMemberInvite() int32 {
-	if x != nil {
-		return x.AllowMemberInvite
+func (m *D89AGroupinfo) GetAllowMemberInvite() isD89AGroupinfo_AllowMemberInvite {
+	if m != nil {
+		return m.AllowMemberInvite
+	}
+	return nil
+}
+
+func (x *D89AGroupinfo) GetAllowMemberInviteVal() int32 {
+	if x, ok := x.GetAllowMemberInvite().(*D89AGroupinfo_AllowMemberInviteVal); ok {
+		return x.AllowMemberInviteVal
 	}
 	return 0
 }
@@ -916,6 +934,26 @@ type D89AGroupinfo_Val struct {
 
 func (*D89AGroupinfo_Val) isD89AGroupinfo_ShutupTime() {}
 
+type isD89AGroupinfo_AppPrivilegeFlag interface {
+	isD89AGroupinfo_AppPrivilegeFlag()
+}
+
+type D89AGroupinfo_AppPrivilegeFlagVal struct {
+	AppPrivilegeFlagVal int32 `protobuf:"varint,23,opt,name=appPrivilegeFlagVal,proto3,oneof"`
+}
+
+func (*D89AGroupinfo_AppPrivilegeFlagVal) isD89AGroupinfo_AppPrivilegeFlag() {}
+
+type isD89AGroupinfo_AllowMemberInvite interface {
+	isD89AGroupinfo_AllowMemberInvite()
+}
+
+type D89AGroupinfo_AllowMemberInviteVal struct {
+	AllowMemberInviteVal int32 `protobuf:"varint,29,opt,name=allowMemberInviteVal,proto3,oneof"`
+}
+
+func (*D89AGroupinfo_AllowMemberInviteVal) isD89AGroupinfo_AllowMemberInvite() {}
+
 type D89AGroupNewGuidelinesInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1445,328 +1483,4642 @@ func (x *D8FCClientInfo) GetIngClientver() string {
 	return ""
 }
 
-var File_oidb_proto protoreflect.FileDescriptor
+type DED3ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_oidb_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a,
-	0x0a, 0x4f, 0x49, 0x44, 0x42, 0x53, 0x53, 0x4f, 0x50, 0x6b, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x74, 0x0a, 0x0b, 0x44, 0x38, 0x41, 0x30, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79,
-	0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x70,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x35, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6a, 0x0a, 0x0e, 0x44, 0x38, 0x41, 0x30, 0x4b,
-	0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
-	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x55, 0x69, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69, 0x63, 0x6b,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x70,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f,
-	0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b,
-	0x6f, 0x70, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x22, 0xc6,
-	0x01, 0x0a, 0x0b, 0x44, 0x38, 0x41, 0x30, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2e,
-	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35,
-	0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a,
-	0x07, 0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x22, 0xe3, 0x02, 0x0a, 0x0b, 0x44, 0x38, 0x46, 0x43,
-	0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x6c, 0x61,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x6c, 0x61,
-	0x67, 0x12, 0x33, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x44, 0x38, 0x46, 0x43,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65,
-	0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0d,
-	0x6d, 0x73, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6d, 0x73, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xbd, 0x01,
-	0x0a, 0x0b, 0x44, 0x38, 0x39, 0x41, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x73,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x66, 0x6f,
-	0x52, 0x0b, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a,
-	0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x55, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x69, 0x6e, 0x12,
-	0x2c, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41,
-	0x70, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x22, 0x83, 0x0d,
-	0x0a, 0x0d, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x74, 0x41, 0x64, 0x6d, 0x4e, 0x75,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78,
-	0x74, 0x41, 0x64, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x69,
-	0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
-	0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d,
-	0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
-	0x69, 0x6f, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x69, 0x6f, 0x53, 0x6b, 0x69, 0x6e,
-	0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
-	0x6f, 0x76, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x69, 0x6e, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x69, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d,
-	0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x69, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x12,
-	0x4f, 0x0a, 0x14, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x67, 0x75, 0x69,
-	0x64, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x47, 0x75, 0x69, 0x64,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x14, 0x73, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x67, 0x75, 0x69, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x64, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x61, 0x64, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03,
-	0x76, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x76, 0x61, 0x6c,
-	0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6c, 0x61,
-	0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x12, 0x3b,
-	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6d, 0x73, 0x67, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78, 0x74, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78,
-	0x74, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x54, 0x65, 0x78, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x6e,
-	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x46,
-	0x6c, 0x61, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72,
-	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x61,
-	0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
-	0x65, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f,
-	0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x0d, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x1b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x69,
-	0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12,
-	0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69,
-	0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x1f, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67,
-	0x65, 0x78, 0x74, 0x33, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x46, 0x6c, 0x61, 0x67, 0x65, 0x78, 0x74, 0x33, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x65, 0x78, 0x74, 0x33, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x21,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x65,
-	0x78, 0x74, 0x33, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x12,
-	0x2a, 0x0a, 0x10, 0x6e, 0x6f, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46,
-	0x6c, 0x61, 0x67, 0x18, 0x23, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6e, 0x6f, 0x46, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x6e,
-	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46,
-	0x6c, 0x61, 0x67, 0x18, 0x24, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6e, 0x6f, 0x43, 0x6f, 0x64,
-	0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x73, 0x67, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x26, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x11, 0x6d, 0x73, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x73, 0x68, 0x75, 0x74, 0x75, 0x70, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4e, 0x65, 0x77, 0x47, 0x75, 0x69, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x13, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
-	0x69, 0x62, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x69,
-	0x62, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x6f, 0x72,
-	0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
-	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x22, 0xa2, 0x01, 0x0a, 0x10, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65,
-	0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x6e, 0x67, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x6e, 0x67, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x67,
-	0x47, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0d, 0x69, 0x6e, 0x67, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x6f, 0x69, 0x49, 0x64, 0x22, 0x9b, 0x04, 0x0a, 0x0e, 0x44, 0x38, 0x46, 0x43, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61,
-	0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x75, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x75, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6a, 0x6f, 0x62,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x35, 0x0a, 0x0c, 0x72, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x43, 0x61, 0x72,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x52, 0x0c, 0x72, 0x69, 0x63, 0x68, 0x43,
-	0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x52,
-	0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x52, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x44, 0x38, 0x46, 0x43, 0x43, 0x61, 0x72, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x43,
-	0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65,
-	0x6e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x38, 0x46, 0x43, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0e,
-	0x44, 0x38, 0x46, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x69, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x69, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
-	0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x76, 0x65, 0x72, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x3b,
-	0x6f, 0x69, 0x64, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ToUin     int64 `protobuf:"varint,1,opt,name=toUin,proto3" json:"toUin,omitempty"`
+	GroupCode int64 `protobuf:"varint,2,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	MsgSeq    int32 `protobuf:"varint,3,opt,name=msgSeq,proto3" json:"msgSeq,omitempty"`
+	MsgRand   int32 `protobuf:"varint,4,opt,name=msgRand,proto3" json:"msgRand,omitempty"`
+	AioUin    int64 `protobuf:"varint,5,opt,name=aioUin,proto3" json:"aioUin,omitempty"`
 }
 
-var (
-	file_oidb_proto_rawDescOnce sync.Once
-	file_oidb_proto_rawDescData = file_oidb_proto_rawDesc
-)
+func (x *DED3ReqBody) Reset() {
+	*x = DED3ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_oidb_proto_rawDescGZIP() []byte {
-	file_oidb_proto_rawDescOnce.Do(func() {
-		file_oidb_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidb_proto_rawDescData)
-	})
-	return file_oidb_proto_rawDescData
+func (x *DED3ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_oidb_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_oidb_proto_goTypes = []interface{}{
-	(*OIDBSSOPkg)(nil),                 // 0: OIDBSSOPkg
-	(*D8A0RspBody)(nil),                // 1: D8A0RspBody
-	(*D8A0KickResult)(nil),             // 2: D8A0KickResult
-	(*D8A0KickMemberInfo)(nil),         // 3: D8A0KickMemberInfo
-	(*D8A0ReqBody)(nil),                // 4: D8A0ReqBody
-	(*D8FCReqBody)(nil),                // 5: D8FCReqBody
-	(*D89AReqBody)(nil),                // 6: D89AReqBody
-	(*D89AGroupinfo)(nil),              // 7: D89AGroupinfo
-	(*D89AGroupNewGuidelinesInfo)(nil), // 8: D89AGroupNewGuidelinesInfo
-	(*D89AGroupExInfoOnly)(nil),        // 9: D89AGroupExInfoOnly
-	(*D89AGroupGeoInfo)(nil),           // 10: D89AGroupGeoInfo
-	(*D8FCMemberInfo)(nil),             // 11: D8FCMemberInfo
-	(*D8FCCardNameElem)(nil),           // 12: D8FCCardNameElem
-	(*D8FCLevelName)(nil),              // 13: D8FCLevelName
-	(*D8FCClientInfo)(nil),             // 14: D8FCClientInfo
+func (*DED3ReqBody) ProtoMessage() {}
+
+func (x *DED3ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_oidb_proto_depIdxs = []int32{
-	2,  // 0: D8A0RspBody.msgKickResult:type_name -> D8A0KickResult
-	3,  // 1: D8A0ReqBody.msgKickList:type_name -> D8A0KickMemberInfo
-	11, // 2: D8FCReqBody.memLevelInfo:type_name -> D8FCMemberInfo
-	13, // 3: D8FCReqBody.levelName:type_name -> D8FCLevelName
-	14, // 4: D8FCReqBody.msgClientInfo:type_name -> D8FCClientInfo
-	7,  // 5: D89AReqBody.stGroupInfo:type_name -> D89AGroupinfo
-	8,  // 6: D89AGroupinfo.stGroupNewguidelines:type_name -> D89AGroupNewGuidelinesInfo
-	10, // 7: D89AGroupinfo.msgGroupGeoInfo:type_name -> D89AGroupGeoInfo
-	9,  // 8: D89AGroupinfo.stGroupExInfo:type_name -> D89AGroupExInfoOnly
-	12, // 9: D8FCMemberInfo.richCardName:type_name -> D8FCCardNameElem
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+
+// Deprecated: Use DED3ReqBody.ProtoReflect.Descriptor instead.
+func (*DED3ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_oidb_proto_init() }
-func file_oidb_proto_init() {
-	if File_oidb_proto != nil {
-		return
+func (x *DED3ReqBody) GetToUin() int64 {
+	if x != nil {
+		return x.ToUin
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_oidb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OIDBSSOPkg); i {
+	return 0
+}
+
+func (x *DED3ReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *DED3ReqBody) GetMsgSeq() int32 {
+	if x != nil {
+		return x.MsgSeq
+	}
+	return 0
+}
+
+func (x *DED3ReqBody) GetMsgRand() int32 {
+	if x != nil {
+		return x.MsgRand
+	}
+	return 0
+}
+
+func (x *DED3ReqBody) GetAioUin() int64 {
+	if x != nil {
+		return x.AioUin
+	}
+	return 0
+}
+
+type D758ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinGroupCode    int64                `protobuf:"varint,1,opt,name=joinGroupCode,proto3" json:"joinGroupCode,omitempty"`
+	BeInvitedUinInfo []*D758InviteUinInfo `protobuf:"bytes,2,rep,name=beInvitedUinInfo,proto3" json:"beInvitedUinInfo,omitempty"`
+	Msg              string               `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	MainSourceId     int32                `protobuf:"varint,4,opt,name=mainSourceId,proto3" json:"mainSourceId,omitempty"`
+	SubSourceId      int32                `protobuf:"varint,5,opt,name=subSourceId,proto3" json:"subSourceId,omitempty"`
+}
+
+func (x *D758ReqBody) Reset() {
+	*x = D758ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D758ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D758ReqBody) ProtoMessage() {}
+
+func (x *D758ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D758ReqBody.ProtoReflect.Descriptor instead.
+func (*D758ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *D758ReqBody) GetJoinGroupCode() int64 {
+	if x != nil {
+		return x.JoinGroupCode
+	}
+	return 0
+}
+
+func (x *D758ReqBody) GetBeInvitedUinInfo() []*D758InviteUinInfo {
+	if x != nil {
+		return x.BeInvitedUinInfo
+	}
+	return nil
+}
+
+func (x *D758ReqBody) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *D758ReqBody) GetMainSourceId() int32 {
+	if x != nil {
+		return x.MainSourceId
+	}
+	return 0
+}
+
+func (x *D758ReqBody) GetSubSourceId() int32 {
+	if x != nil {
+		return x.SubSourceId
+	}
+	return 0
+}
+
+type D758InviteUinInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uin   int64 `protobuf:"varint,1,opt,name=uin,proto3" json:"uin,omitempty"`
+	Judge int64 `protobuf:"varint,2,opt,name=judge,proto3" json:"judge,omitempty"`
+}
+
+func (x *D758InviteUinInfo) Reset() {
+	*x = D758InviteUinInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D758InviteUinInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D758InviteUinInfo) ProtoMessage() {}
+
+func (x *D758InviteUinInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D758InviteUinInfo.ProtoReflect.Descriptor instead.
+func (*D758InviteUinInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *D758InviteUinInfo) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *D758InviteUinInfo) GetJudge() int64 {
+	if x != nil {
+		return x.Judge
+	}
+	return 0
+}
+
+type D6D6ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadFileReq   *D6D6UploadFileReqBody   `protobuf:"bytes,1,opt,name=uploadFileReq,proto3" json:"uploadFileReq,omitempty"`
+	DownloadFileReq *D6D6DownloadFileReqBody `protobuf:"bytes,3,opt,name=downloadFileReq,proto3" json:"downloadFileReq,omitempty"`
+	DeleteFileReq   *D6D6DeleteFileReqBody   `protobuf:"bytes,4,opt,name=deleteFileReq,proto3" json:"deleteFileReq,omitempty"`
+	MoveFileReq     *D6D6MoveFileReqBody     `protobuf:"bytes,6,opt,name=moveFileReq,proto3" json:"moveFileReq,omitempty"`
+}
+
+func (x *D6D6ReqBody) Reset() {
+	*x = D6D6ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6ReqBody) ProtoMessage() {}
+
+func (x *D6D6ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6ReqBody.ProtoReflect.Descriptor instead.
+func (*D6D6ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *D6D6ReqBody) GetUploadFileReq() *D6D6UploadFileReqBody {
+	if x != nil {
+		return x.UploadFileReq
+	}
+	return nil
+}
+
+func (x *D6D6ReqBody) GetDownloadFileReq() *D6D6DownloadFileReqBody {
+	if x != nil {
+		return x.DownloadFileReq
+	}
+	return nil
+}
+
+func (x *D6D6ReqBody) GetDeleteFileReq() *D6D6DeleteFileReqBody {
+	if x != nil {
+		return x.DeleteFileReq
+	}
+	return nil
+}
+
+func (x *D6D6ReqBody) GetMoveFileReq() *D6D6MoveFileReqBody {
+	if x != nil {
+		return x.MoveFileReq
+	}
+	return nil
+}
+
+type D6D6UploadFileReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode          int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId              int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	BusId              int32  `protobuf:"varint,3,opt,name=busId,proto3" json:"busId,omitempty"`
+	Entrance           int32  `protobuf:"varint,4,opt,name=entrance,proto3" json:"entrance,omitempty"`
+	ParentFolderId     string `protobuf:"bytes,5,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+	FileName           string `protobuf:"bytes,6,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	LocalPath          string `protobuf:"bytes,7,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	Int64FileSize      int64  `protobuf:"varint,8,opt,name=int64FileSize,proto3" json:"int64FileSize,omitempty"`
+	Sha                []byte `protobuf:"bytes,9,opt,name=sha,proto3" json:"sha,omitempty"`
+	Sha3               []byte `protobuf:"bytes,10,opt,name=sha3,proto3" json:"sha3,omitempty"`
+	Md5                []byte `protobuf:"bytes,11,opt,name=md5,proto3" json:"md5,omitempty"`
+	SupportMultiUpload bool   `protobuf:"varint,15,opt,name=supportMultiUpload,proto3" json:"supportMultiUpload,omitempty"`
+}
+
+func (x *D6D6UploadFileReqBody) Reset() {
+	*x = D6D6UploadFileReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6UploadFileReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6UploadFileReqBody) ProtoMessage() {}
+
+func (x *D6D6UploadFileReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6UploadFileReqBody.ProtoReflect.Descriptor instead.
+func (*D6D6UploadFileReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *D6D6UploadFileReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileReqBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileReqBody) GetEntrance() int32 {
+	if x != nil {
+		return x.Entrance
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileReqBody) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileReqBody) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileReqBody) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileReqBody) GetInt64FileSize() int64 {
+	if x != nil {
+		return x.Int64FileSize
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileReqBody) GetSha() []byte {
+	if x != nil {
+		return x.Sha
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileReqBody) GetSha3() []byte {
+	if x != nil {
+		return x.Sha3
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileReqBody) GetMd5() []byte {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileReqBody) GetSupportMultiUpload() bool {
+	if x != nil {
+		return x.SupportMultiUpload
+	}
+	return false
+}
+
+type D6D6DownloadFileReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode        int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId            int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	BusId            int32  `protobuf:"varint,3,opt,name=busId,proto3" json:"busId,omitempty"`
+	FileId           string `protobuf:"bytes,4,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	BoolThumbnailReq bool   `protobuf:"varint,5,opt,name=boolThumbnailReq,proto3" json:"boolThumbnailReq,omitempty"`
+	UrlType          int32  `protobuf:"varint,6,opt,name=urlType,proto3" json:"urlType,omitempty"`
+	BoolPreviewReq   bool   `protobuf:"varint,7,opt,name=boolPreviewReq,proto3" json:"boolPreviewReq,omitempty"`
+}
+
+func (x *D6D6DownloadFileReqBody) Reset() {
+	*x = D6D6DownloadFileReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6DownloadFileReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6DownloadFileReqBody) ProtoMessage() {}
+
+func (x *D6D6DownloadFileReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6DownloadFileReqBody.ProtoReflect.Descriptor instead.
+func (*D6D6DownloadFileReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *D6D6DownloadFileReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D6DownloadFileReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D6DownloadFileReqBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D6DownloadFileReqBody) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *D6D6DownloadFileReqBody) GetBoolThumbnailReq() bool {
+	if x != nil {
+		return x.BoolThumbnailReq
+	}
+	return false
+}
+
+func (x *D6D6DownloadFileReqBody) GetUrlType() int32 {
+	if x != nil {
+		return x.UrlType
+	}
+	return 0
+}
+
+func (x *D6D6DownloadFileReqBody) GetBoolPreviewReq() bool {
+	if x != nil {
+		return x.BoolPreviewReq
+	}
+	return false
+}
+
+type D6D6DeleteFileReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode      int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId          int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	BusId          int32  `protobuf:"varint,3,opt,name=busId,proto3" json:"busId,omitempty"`
+	ParentFolderId string `protobuf:"bytes,4,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+	FileId         string `protobuf:"bytes,5,opt,name=fileId,proto3" json:"fileId,omitempty"`
+}
+
+func (x *D6D6DeleteFileReqBody) Reset() {
+	*x = D6D6DeleteFileReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6DeleteFileReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6DeleteFileReqBody) ProtoMessage() {}
+
+func (x *D6D6DeleteFileReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6DeleteFileReqBody.ProtoReflect.Descriptor instead.
+func (*D6D6DeleteFileReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *D6D6DeleteFileReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D6DeleteFileReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D6DeleteFileReqBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D6DeleteFileReqBody) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+func (x *D6D6DeleteFileReqBody) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type D6D6MoveFileReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode      int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId          int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	BusId          int32  `protobuf:"varint,3,opt,name=busId,proto3" json:"busId,omitempty"`
+	FileId         string `protobuf:"bytes,4,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	ParentFolderId string `protobuf:"bytes,5,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+	DestFolderId   string `protobuf:"bytes,6,opt,name=destFolderId,proto3" json:"destFolderId,omitempty"`
+}
+
+func (x *D6D6MoveFileReqBody) Reset() {
+	*x = D6D6MoveFileReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6MoveFileReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6MoveFileReqBody) ProtoMessage() {}
+
+func (x *D6D6MoveFileReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6MoveFileReqBody.ProtoReflect.Descriptor instead.
+func (*D6D6MoveFileReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *D6D6MoveFileReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D6MoveFileReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D6MoveFileReqBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D6MoveFileReqBody) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *D6D6MoveFileReqBody) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+func (x *D6D6MoveFileReqBody) GetDestFolderId() string {
+	if x != nil {
+		return x.DestFolderId
+	}
+	return ""
+}
+
+type D6D6RspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadFileRsp   *D6D6UploadFileRspBody   `protobuf:"bytes,1,opt,name=uploadFileRsp,proto3" json:"uploadFileRsp,omitempty"`
+	DownloadFileRsp *D6D6DownloadFileRspBody `protobuf:"bytes,3,opt,name=downloadFileRsp,proto3" json:"downloadFileRsp,omitempty"`
+	DeleteFileRsp   *D6D6DeleteFileRspBody   `protobuf:"bytes,4,opt,name=deleteFileRsp,proto3" json:"deleteFileRsp,omitempty"`
+	MoveFileRsp     *D6D6MoveFileRspBody     `protobuf:"bytes,6,opt,name=moveFileRsp,proto3" json:"moveFileRsp,omitempty"`
+}
+
+func (x *D6D6RspBody) Reset() {
+	*x = D6D6RspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6RspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6RspBody) ProtoMessage() {}
+
+func (x *D6D6RspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6RspBody.ProtoReflect.Descriptor instead.
+func (*D6D6RspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *D6D6RspBody) GetUploadFileRsp() *D6D6UploadFileRspBody {
+	if x != nil {
+		return x.UploadFileRsp
+	}
+	return nil
+}
+
+func (x *D6D6RspBody) GetDownloadFileRsp() *D6D6DownloadFileRspBody {
+	if x != nil {
+		return x.DownloadFileRsp
+	}
+	return nil
+}
+
+func (x *D6D6RspBody) GetDeleteFileRsp() *D6D6DeleteFileRspBody {
+	if x != nil {
+		return x.DeleteFileRsp
+	}
+	return nil
+}
+
+func (x *D6D6RspBody) GetMoveFileRsp() *D6D6MoveFileRspBody {
+	if x != nil {
+		return x.MoveFileRsp
+	}
+	return nil
+}
+
+type D6D6UploadFileRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32    `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string   `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string   `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	UploadIp      string   `protobuf:"bytes,4,opt,name=uploadIp,proto3" json:"uploadIp,omitempty"`
+	ServerDns     string   `protobuf:"bytes,5,opt,name=serverDns,proto3" json:"serverDns,omitempty"`
+	BusId         int32    `protobuf:"varint,6,opt,name=busId,proto3" json:"busId,omitempty"`
+	FileId        string   `protobuf:"bytes,7,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	CheckKey      []byte   `protobuf:"bytes,8,opt,name=checkKey,proto3" json:"checkKey,omitempty"`
+	FileKey       []byte   `protobuf:"bytes,9,opt,name=fileKey,proto3" json:"fileKey,omitempty"`
+	BoolFileExist bool     `protobuf:"varint,10,opt,name=boolFileExist,proto3" json:"boolFileExist,omitempty"`
+	UploadIpLanV4 []string `protobuf:"bytes,12,rep,name=uploadIpLanV4,proto3" json:"uploadIpLanV4,omitempty"`
+	UploadIpLanV6 []string `protobuf:"bytes,13,rep,name=uploadIpLanV6,proto3" json:"uploadIpLanV6,omitempty"`
+	UploadPort    int32    `protobuf:"varint,14,opt,name=uploadPort,proto3" json:"uploadPort,omitempty"`
+}
+
+func (x *D6D6UploadFileRspBody) Reset() {
+	*x = D6D6UploadFileRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6UploadFileRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6UploadFileRspBody) ProtoMessage() {}
+
+func (x *D6D6UploadFileRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6UploadFileRspBody.ProtoReflect.Descriptor instead.
+func (*D6D6UploadFileRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *D6D6UploadFileRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileRspBody) GetUploadIp() string {
+	if x != nil {
+		return x.UploadIp
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileRspBody) GetServerDns() string {
+	if x != nil {
+		return x.ServerDns
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileRspBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D6UploadFileRspBody) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *D6D6UploadFileRspBody) GetCheckKey() []byte {
+	if x != nil {
+		return x.CheckKey
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileRspBody) GetFileKey() []byte {
+	if x != nil {
+		return x.FileKey
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileRspBody) GetBoolFileExist() bool {
+	if x != nil {
+		return x.BoolFileExist
+	}
+	return false
+}
+
+func (x *D6D6UploadFileRspBody) GetUploadIpLanV4() []string {
+	if x != nil {
+		return x.UploadIpLanV4
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileRspBody) GetUploadIpLanV6() []string {
+	if x != nil {
+		return x.UploadIpLanV6
+	}
+	return nil
+}
+
+func (x *D6D6UploadFileRspBody) GetUploadPort() int32 {
+	if x != nil {
+		return x.UploadPort
+	}
+	return 0
+}
+
+type D6D6DownloadFileRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	DownloadIp    string `protobuf:"bytes,4,opt,name=downloadIp,proto3" json:"downloadIp,omitempty"`
+	DownloadDns   []byte `protobuf:"bytes,5,opt,name=downloadDns,proto3" json:"downloadDns,omitempty"`
+	DownloadUrl   []byte `protobuf:"bytes,6,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`
+	Sha           []byte `protobuf:"bytes,7,opt,name=sha,proto3" json:"sha,omitempty"`
+	Sha3          []byte `protobuf:"bytes,8,opt,name=sha3,proto3" json:"sha3,omitempty"`
+	Md5           []byte `protobuf:"bytes,9,opt,name=md5,proto3" json:"md5,omitempty"`
+	CookieVal     []byte `protobuf:"bytes,10,opt,name=cookieVal,proto3" json:"cookieVal,omitempty"`
+	SaveFileName  string `protobuf:"bytes,11,opt,name=saveFileName,proto3" json:"saveFileName,omitempty"`
+	PreviewPort   int32  `protobuf:"varint,12,opt,name=previewPort,proto3" json:"previewPort,omitempty"`
+}
+
+func (x *D6D6DownloadFileRspBody) Reset() {
+	*x = D6D6DownloadFileRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6DownloadFileRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6DownloadFileRspBody) ProtoMessage() {}
+
+func (x *D6D6DownloadFileRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6DownloadFileRspBody.ProtoReflect.Descriptor instead.
+func (*D6D6DownloadFileRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *D6D6DownloadFileRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D6DownloadFileRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D6DownloadFileRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D6DownloadFileRspBody) GetDownloadIp() string {
+	if x != nil {
+		return x.DownloadIp
+	}
+	return ""
+}
+
+func (x *D6D6DownloadFileRspBody) GetDownloadDns() []byte {
+	if x != nil {
+		return x.DownloadDns
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetDownloadUrl() []byte {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetSha() []byte {
+	if x != nil {
+		return x.Sha
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetSha3() []byte {
+	if x != nil {
+		return x.Sha3
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetMd5() []byte {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetCookieVal() []byte {
+	if x != nil {
+		return x.CookieVal
+	}
+	return nil
+}
+
+func (x *D6D6DownloadFileRspBody) GetSaveFileName() string {
+	if x != nil {
+		return x.SaveFileName
+	}
+	return ""
+}
+
+func (x *D6D6DownloadFileRspBody) GetPreviewPort() int32 {
+	if x != nil {
+		return x.PreviewPort
+	}
+	return 0
+}
+
+type D6D6DeleteFileRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+}
+
+func (x *D6D6DeleteFileRspBody) Reset() {
+	*x = D6D6DeleteFileRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6DeleteFileRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6DeleteFileRspBody) ProtoMessage() {}
+
+func (x *D6D6DeleteFileRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6DeleteFileRspBody.ProtoReflect.Descriptor instead.
+func (*D6D6DeleteFileRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *D6D6DeleteFileRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D6DeleteFileRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D6DeleteFileRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+type D6D6MoveFileRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode        int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg         string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording  string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	ParentFolderId string `protobuf:"bytes,4,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+}
+
+func (x *D6D6MoveFileRspBody) Reset() {
+	*x = D6D6MoveFileRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D6MoveFileRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D6MoveFileRspBody) ProtoMessage() {}
+
+func (x *D6D6MoveFileRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D6MoveFileRspBody.ProtoReflect.Descriptor instead.
+func (*D6D6MoveFileRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *D6D6MoveFileRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D6MoveFileRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D6MoveFileRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D6MoveFileRspBody) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+type D6D7ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateFolderReq *D6D7CreateFolderReqBody `protobuf:"bytes,1,opt,name=createFolderReq,proto3" json:"createFolderReq,omitempty"`
+	DeleteFolderReq *D6D7DeleteFolderReqBody `protobuf:"bytes,2,opt,name=deleteFolderReq,proto3" json:"deleteFolderReq,omitempty"`
+	RenameFolderReq *D6D7RenameFolderReqBody `protobuf:"bytes,3,opt,name=renameFolderReq,proto3" json:"renameFolderReq,omitempty"`
+}
+
+func (x *D6D7ReqBody) Reset() {
+	*x = D6D7ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7ReqBody) ProtoMessage() {}
+
+func (x *D6D7ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7ReqBody.ProtoReflect.Descriptor instead.
+func (*D6D7ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *D6D7ReqBody) GetCreateFolderReq() *D6D7CreateFolderReqBody {
+	if x != nil {
+		return x.CreateFolderReq
+	}
+	return nil
+}
+
+func (x *D6D7ReqBody) GetDeleteFolderReq() *D6D7DeleteFolderReqBody {
+	if x != nil {
+		return x.DeleteFolderReq
+	}
+	return nil
+}
+
+func (x *D6D7ReqBody) GetRenameFolderReq() *D6D7RenameFolderReqBody {
+	if x != nil {
+		return x.RenameFolderReq
+	}
+	return nil
+}
+
+type D6D7CreateFolderReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode      int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId          int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	ParentFolderId string `protobuf:"bytes,3,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+	FolderName     string `protobuf:"bytes,4,opt,name=folderName,proto3" json:"folderName,omitempty"`
+}
+
+func (x *D6D7CreateFolderReqBody) Reset() {
+	*x = D6D7CreateFolderReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7CreateFolderReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7CreateFolderReqBody) ProtoMessage() {}
+
+func (x *D6D7CreateFolderReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7CreateFolderReqBody.ProtoReflect.Descriptor instead.
+func (*D6D7CreateFolderReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *D6D7CreateFolderReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D7CreateFolderReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D7CreateFolderReqBody) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+func (x *D6D7CreateFolderReqBody) GetFolderName() string {
+	if x != nil {
+		return x.FolderName
+	}
+	return ""
+}
+
+type D6D7DeleteFolderReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId     int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	FolderId  string `protobuf:"bytes,3,opt,name=folderId,proto3" json:"folderId,omitempty"`
+}
+
+func (x *D6D7DeleteFolderReqBody) Reset() {
+	*x = D6D7DeleteFolderReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7DeleteFolderReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7DeleteFolderReqBody) ProtoMessage() {}
+
+func (x *D6D7DeleteFolderReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7DeleteFolderReqBody.ProtoReflect.Descriptor instead.
+func (*D6D7DeleteFolderReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *D6D7DeleteFolderReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D7DeleteFolderReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D7DeleteFolderReqBody) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type D6D7RenameFolderReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode     int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId         int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	FolderId      string `protobuf:"bytes,3,opt,name=folderId,proto3" json:"folderId,omitempty"`
+	NewFolderName string `protobuf:"bytes,4,opt,name=newFolderName,proto3" json:"newFolderName,omitempty"`
+}
+
+func (x *D6D7RenameFolderReqBody) Reset() {
+	*x = D6D7RenameFolderReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7RenameFolderReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7RenameFolderReqBody) ProtoMessage() {}
+
+func (x *D6D7RenameFolderReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7RenameFolderReqBody.ProtoReflect.Descriptor instead.
+func (*D6D7RenameFolderReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *D6D7RenameFolderReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D7RenameFolderReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D7RenameFolderReqBody) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *D6D7RenameFolderReqBody) GetNewFolderName() string {
+	if x != nil {
+		return x.NewFolderName
+	}
+	return ""
+}
+
+type D6D7RspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateFolderRsp *D6D7FolderRspBody `protobuf:"bytes,1,opt,name=createFolderRsp,proto3" json:"createFolderRsp,omitempty"`
+	DeleteFolderRsp *D6D7FolderRspBody `protobuf:"bytes,2,opt,name=deleteFolderRsp,proto3" json:"deleteFolderRsp,omitempty"`
+	RenameFolderRsp *D6D7FolderRspBody `protobuf:"bytes,3,opt,name=renameFolderRsp,proto3" json:"renameFolderRsp,omitempty"`
+}
+
+func (x *D6D7RspBody) Reset() {
+	*x = D6D7RspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7RspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7RspBody) ProtoMessage() {}
+
+func (x *D6D7RspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7RspBody.ProtoReflect.Descriptor instead.
+func (*D6D7RspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *D6D7RspBody) GetCreateFolderRsp() *D6D7FolderRspBody {
+	if x != nil {
+		return x.CreateFolderRsp
+	}
+	return nil
+}
+
+func (x *D6D7RspBody) GetDeleteFolderRsp() *D6D7FolderRspBody {
+	if x != nil {
+		return x.DeleteFolderRsp
+	}
+	return nil
+}
+
+func (x *D6D7RspBody) GetRenameFolderRsp() *D6D7FolderRspBody {
+	if x != nil {
+		return x.RenameFolderRsp
+	}
+	return nil
+}
+
+type D6D7FolderRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+}
+
+func (x *D6D7FolderRspBody) Reset() {
+	*x = D6D7FolderRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D7FolderRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D7FolderRspBody) ProtoMessage() {}
+
+func (x *D6D7FolderRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D7FolderRspBody.ProtoReflect.Descriptor instead.
+func (*D6D7FolderRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *D6D7FolderRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D7FolderRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D7FolderRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+type D6D8ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileListInfoReq *D6D8GetFileListReqBody  `protobuf:"bytes,2,opt,name=fileListInfoReq,proto3" json:"fileListInfoReq,omitempty"`
+	GroupFileCntReq *D6D8GetFileCountReqBody `protobuf:"bytes,3,opt,name=groupFileCntReq,proto3" json:"groupFileCntReq,omitempty"`
+	GroupSpaceReq   *D6D8GetSpaceReqBody     `protobuf:"bytes,4,opt,name=groupSpaceReq,proto3" json:"groupSpaceReq,omitempty"`
+}
+
+func (x *D6D8ReqBody) Reset() {
+	*x = D6D8ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8ReqBody) ProtoMessage() {}
+
+func (x *D6D8ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8ReqBody.ProtoReflect.Descriptor instead.
+func (*D6D8ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *D6D8ReqBody) GetFileListInfoReq() *D6D8GetFileListReqBody {
+	if x != nil {
+		return x.FileListInfoReq
+	}
+	return nil
+}
+
+func (x *D6D8ReqBody) GetGroupFileCntReq() *D6D8GetFileCountReqBody {
+	if x != nil {
+		return x.GroupFileCntReq
+	}
+	return nil
+}
+
+func (x *D6D8ReqBody) GetGroupSpaceReq() *D6D8GetSpaceReqBody {
+	if x != nil {
+		return x.GroupSpaceReq
+	}
+	return nil
+}
+
+type D6D8GetFileListReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode     int64  `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId         int32  `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	FolderId      string `protobuf:"bytes,3,opt,name=folderId,proto3" json:"folderId,omitempty"`
+	FileCount     int32  `protobuf:"varint,5,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
+	AllFileCount  int32  `protobuf:"varint,7,opt,name=allFileCount,proto3" json:"allFileCount,omitempty"`
+	ReqFrom       int32  `protobuf:"varint,8,opt,name=reqFrom,proto3" json:"reqFrom,omitempty"`
+	SortBy        int32  `protobuf:"varint,9,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	FilterCode    int32  `protobuf:"varint,10,opt,name=filterCode,proto3" json:"filterCode,omitempty"`
+	Uin           int64  `protobuf:"varint,11,opt,name=uin,proto3" json:"uin,omitempty"`
+	FieldFlag     int32  `protobuf:"varint,12,opt,name=fieldFlag,proto3" json:"fieldFlag,omitempty"`
+	StartIndex    int32  `protobuf:"varint,13,opt,name=startIndex,proto3" json:"startIndex,omitempty"`
+	Context       []byte `protobuf:"bytes,14,opt,name=context,proto3" json:"context,omitempty"`
+	ClientVersion int32  `protobuf:"varint,15,opt,name=clientVersion,proto3" json:"clientVersion,omitempty"`
+}
+
+func (x *D6D8GetFileListReqBody) Reset() {
+	*x = D6D8GetFileListReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetFileListReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetFileListReqBody) ProtoMessage() {}
+
+func (x *D6D8GetFileListReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetFileListReqBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetFileListReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *D6D8GetFileListReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *D6D8GetFileListReqBody) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetAllFileCount() int32 {
+	if x != nil {
+		return x.AllFileCount
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetReqFrom() int32 {
+	if x != nil {
+		return x.ReqFrom
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetSortBy() int32 {
+	if x != nil {
+		return x.SortBy
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetFilterCode() int32 {
+	if x != nil {
+		return x.FilterCode
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetUin() int64 {
+	if x != nil {
+		return x.Uin
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetFieldFlag() int32 {
+	if x != nil {
+		return x.FieldFlag
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetStartIndex() int32 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListReqBody) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *D6D8GetFileListReqBody) GetClientVersion() int32 {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return 0
+}
+
+type D6D8GetFileCountReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode int64 `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId     int32 `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	BusId     int32 `protobuf:"varint,3,opt,name=busId,proto3" json:"busId,omitempty"`
+}
+
+func (x *D6D8GetFileCountReqBody) Reset() {
+	*x = D6D8GetFileCountReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetFileCountReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetFileCountReqBody) ProtoMessage() {}
+
+func (x *D6D8GetFileCountReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetFileCountReqBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetFileCountReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *D6D8GetFileCountReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D8GetFileCountReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D8GetFileCountReqBody) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+type D6D8GetSpaceReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode int64 `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId     int32 `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+}
+
+func (x *D6D8GetSpaceReqBody) Reset() {
+	*x = D6D8GetSpaceReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetSpaceReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetSpaceReqBody) ProtoMessage() {}
+
+func (x *D6D8GetSpaceReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetSpaceReqBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetSpaceReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *D6D8GetSpaceReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D8GetSpaceReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+type D6D8RspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileListInfoRsp *D6D8GetFileListRspBody  `protobuf:"bytes,2,opt,name=fileListInfoRsp,proto3" json:"fileListInfoRsp,omitempty"`
+	GroupFileCntRsp *D6D8GetFileCountRspBody `protobuf:"bytes,3,opt,name=groupFileCntRsp,proto3" json:"groupFileCntRsp,omitempty"`
+	GroupSpaceRsp   *D6D8GetSpaceRspBody     `protobuf:"bytes,4,opt,name=groupSpaceRsp,proto3" json:"groupSpaceRsp,omitempty"`
+}
+
+func (x *D6D8RspBody) Reset() {
+	*x = D6D8RspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8RspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8RspBody) ProtoMessage() {}
+
+func (x *D6D8RspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8RspBody.ProtoReflect.Descriptor instead.
+func (*D6D8RspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *D6D8RspBody) GetFileListInfoRsp() *D6D8GetFileListRspBody {
+	if x != nil {
+		return x.FileListInfoRsp
+	}
+	return nil
+}
+
+func (x *D6D8RspBody) GetGroupFileCntRsp() *D6D8GetFileCountRspBody {
+	if x != nil {
+		return x.GroupFileCntRsp
+	}
+	return nil
+}
+
+func (x *D6D8RspBody) GetGroupSpaceRsp() *D6D8GetSpaceRspBody {
+	if x != nil {
+		return x.GroupSpaceRsp
+	}
+	return nil
+}
+
+type D6D8GetFileListRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32               `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string              `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string              `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	IsEnd         bool                `protobuf:"varint,4,opt,name=isEnd,proto3" json:"isEnd,omitempty"`
+	ItemList      []*D6D8FileListItem `protobuf:"bytes,5,rep,name=itemList,proto3" json:"itemList,omitempty"`
+	AllFileCount  int32               `protobuf:"varint,7,opt,name=allFileCount,proto3" json:"allFileCount,omitempty"`
+	NextIndex     int32               `protobuf:"varint,13,opt,name=nextIndex,proto3" json:"nextIndex,omitempty"`
+	Context       []byte              `protobuf:"bytes,14,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *D6D8GetFileListRspBody) Reset() {
+	*x = D6D8GetFileListRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetFileListRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetFileListRspBody) ProtoMessage() {}
+
+func (x *D6D8GetFileListRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetFileListRspBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetFileListRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *D6D8GetFileListRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D8GetFileListRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D8GetFileListRspBody) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+func (x *D6D8GetFileListRspBody) GetItemList() []*D6D8FileListItem {
+	if x != nil {
+		return x.ItemList
+	}
+	return nil
+}
+
+func (x *D6D8GetFileListRspBody) GetAllFileCount() int32 {
+	if x != nil {
+		return x.AllFileCount
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListRspBody) GetNextIndex() int32 {
+	if x != nil {
+		return x.NextIndex
+	}
+	return 0
+}
+
+func (x *D6D8GetFileListRspBody) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type D6D8FileListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       int32                `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	FolderInfo *D6D8GroupFolderInfo `protobuf:"bytes,2,opt,name=folderInfo,proto3" json:"folderInfo,omitempty"`
+	FileInfo   *D6D8GroupFileInfo   `protobuf:"bytes,3,opt,name=fileInfo,proto3" json:"fileInfo,omitempty"`
+}
+
+func (x *D6D8FileListItem) Reset() {
+	*x = D6D8FileListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8FileListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8FileListItem) ProtoMessage() {}
+
+func (x *D6D8FileListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8FileListItem.ProtoReflect.Descriptor instead.
+func (*D6D8FileListItem) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *D6D8FileListItem) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *D6D8FileListItem) GetFolderInfo() *D6D8GroupFolderInfo {
+	if x != nil {
+		return x.FolderInfo
+	}
+	return nil
+}
+
+func (x *D6D8FileListItem) GetFileInfo() *D6D8GroupFileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+type D6D8GroupFileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId         string `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	FileName       string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileSize       int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	BusId          int32  `protobuf:"varint,4,opt,name=busId,proto3" json:"busId,omitempty"`
+	UploadedSize   int64  `protobuf:"varint,5,opt,name=uploadedSize,proto3" json:"uploadedSize,omitempty"`
+	UploadTime     int64  `protobuf:"varint,6,opt,name=uploadTime,proto3" json:"uploadTime,omitempty"`
+	DeadTime       int64  `protobuf:"varint,7,opt,name=deadTime,proto3" json:"deadTime,omitempty"`
+	ModifyTime     int64  `protobuf:"varint,8,opt,name=modifyTime,proto3" json:"modifyTime,omitempty"`
+	DownloadTimes  int64  `protobuf:"varint,9,opt,name=downloadTimes,proto3" json:"downloadTimes,omitempty"`
+	Sha            []byte `protobuf:"bytes,10,opt,name=sha,proto3" json:"sha,omitempty"`
+	Sha3           []byte `protobuf:"bytes,11,opt,name=sha3,proto3" json:"sha3,omitempty"`
+	Md5            []byte `protobuf:"bytes,12,opt,name=md5,proto3" json:"md5,omitempty"`
+	LocalPath      string `protobuf:"bytes,13,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	UploaderName   string `protobuf:"bytes,14,opt,name=uploaderName,proto3" json:"uploaderName,omitempty"`
+	UploaderUin    int64  `protobuf:"varint,15,opt,name=uploaderUin,proto3" json:"uploaderUin,omitempty"`
+	ParentFolderId string `protobuf:"bytes,16,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+}
+
+func (x *D6D8GroupFileInfo) Reset() {
+	*x = D6D8GroupFileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GroupFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GroupFileInfo) ProtoMessage() {}
+
+func (x *D6D8GroupFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GroupFileInfo.ProtoReflect.Descriptor instead.
+func (*D6D8GroupFileInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *D6D8GroupFileInfo) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *D6D8GroupFileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *D6D8GroupFileInfo) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetUploadedSize() int64 {
+	if x != nil {
+		return x.UploadedSize
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetUploadTime() int64 {
+	if x != nil {
+		return x.UploadTime
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetDeadTime() int64 {
+	if x != nil {
+		return x.DeadTime
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetDownloadTimes() int64 {
+	if x != nil {
+		return x.DownloadTimes
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetSha() []byte {
+	if x != nil {
+		return x.Sha
+	}
+	return nil
+}
+
+func (x *D6D8GroupFileInfo) GetSha3() []byte {
+	if x != nil {
+		return x.Sha3
+	}
+	return nil
+}
+
+func (x *D6D8GroupFileInfo) GetMd5() []byte {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *D6D8GroupFileInfo) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *D6D8GroupFileInfo) GetUploaderName() string {
+	if x != nil {
+		return x.UploaderName
+	}
+	return ""
+}
+
+func (x *D6D8GroupFileInfo) GetUploaderUin() int64 {
+	if x != nil {
+		return x.UploaderUin
+	}
+	return 0
+}
+
+func (x *D6D8GroupFileInfo) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+type D6D8GroupFolderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId       string `protobuf:"bytes,1,opt,name=folderId,proto3" json:"folderId,omitempty"`
+	ParentFolderId string `protobuf:"bytes,2,opt,name=parentFolderId,proto3" json:"parentFolderId,omitempty"`
+	FolderName     string `protobuf:"bytes,3,opt,name=folderName,proto3" json:"folderName,omitempty"`
+	CreateTime     int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	ModifyTime     int64  `protobuf:"varint,5,opt,name=modifyTime,proto3" json:"modifyTime,omitempty"`
+	CreateUin      int64  `protobuf:"varint,6,opt,name=createUin,proto3" json:"createUin,omitempty"`
+	CreatorName    string `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName,omitempty"`
+	TotalFileCount int32  `protobuf:"varint,8,opt,name=totalFileCount,proto3" json:"totalFileCount,omitempty"`
+}
+
+func (x *D6D8GroupFolderInfo) Reset() {
+	*x = D6D8GroupFolderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GroupFolderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GroupFolderInfo) ProtoMessage() {}
+
+func (x *D6D8GroupFolderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GroupFolderInfo.ProtoReflect.Descriptor instead.
+func (*D6D8GroupFolderInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *D6D8GroupFolderInfo) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *D6D8GroupFolderInfo) GetParentFolderId() string {
+	if x != nil {
+		return x.ParentFolderId
+	}
+	return ""
+}
+
+func (x *D6D8GroupFolderInfo) GetFolderName() string {
+	if x != nil {
+		return x.FolderName
+	}
+	return ""
+}
+
+func (x *D6D8GroupFolderInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *D6D8GroupFolderInfo) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+func (x *D6D8GroupFolderInfo) GetCreateUin() int64 {
+	if x != nil {
+		return x.CreateUin
+	}
+	return 0
+}
+
+func (x *D6D8GroupFolderInfo) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *D6D8GroupFolderInfo) GetTotalFileCount() int32 {
+	if x != nil {
+		return x.TotalFileCount
+	}
+	return 0
+}
+
+type D6D8GetFileCountRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	AllFileCount  int32  `protobuf:"varint,4,opt,name=allFileCount,proto3" json:"allFileCount,omitempty"`
+	FileTooMany   bool   `protobuf:"varint,5,opt,name=fileTooMany,proto3" json:"fileTooMany,omitempty"`
+	LimitCount    int32  `protobuf:"varint,6,opt,name=limitCount,proto3" json:"limitCount,omitempty"`
+	IsFull        bool   `protobuf:"varint,7,opt,name=isFull,proto3" json:"isFull,omitempty"`
+}
+
+func (x *D6D8GetFileCountRspBody) Reset() {
+	*x = D6D8GetFileCountRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetFileCountRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetFileCountRspBody) ProtoMessage() {}
+
+func (x *D6D8GetFileCountRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetFileCountRspBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetFileCountRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *D6D8GetFileCountRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D8GetFileCountRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D8GetFileCountRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D8GetFileCountRspBody) GetAllFileCount() int32 {
+	if x != nil {
+		return x.AllFileCount
+	}
+	return 0
+}
+
+func (x *D6D8GetFileCountRspBody) GetFileTooMany() bool {
+	if x != nil {
+		return x.FileTooMany
+	}
+	return false
+}
+
+func (x *D6D8GetFileCountRspBody) GetLimitCount() int32 {
+	if x != nil {
+		return x.LimitCount
+	}
+	return 0
+}
+
+func (x *D6D8GetFileCountRspBody) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+type D6D8GetSpaceRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+	TotalSpace    int64  `protobuf:"varint,4,opt,name=totalSpace,proto3" json:"totalSpace,omitempty"`
+	UsedSpace     int64  `protobuf:"varint,5,opt,name=usedSpace,proto3" json:"usedSpace,omitempty"`
+	AllUpload     bool   `protobuf:"varint,6,opt,name=allUpload,proto3" json:"allUpload,omitempty"`
+}
+
+func (x *D6D8GetSpaceRspBody) Reset() {
+	*x = D6D8GetSpaceRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D8GetSpaceRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D8GetSpaceRspBody) ProtoMessage() {}
+
+func (x *D6D8GetSpaceRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D8GetSpaceRspBody.ProtoReflect.Descriptor instead.
+func (*D6D8GetSpaceRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *D6D8GetSpaceRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D8GetSpaceRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D8GetSpaceRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+func (x *D6D8GetSpaceRspBody) GetTotalSpace() int64 {
+	if x != nil {
+		return x.TotalSpace
+	}
+	return 0
+}
+
+func (x *D6D8GetSpaceRspBody) GetUsedSpace() int64 {
+	if x != nil {
+		return x.UsedSpace
+	}
+	return 0
+}
+
+func (x *D6D8GetSpaceRspBody) GetAllUpload() bool {
+	if x != nil {
+		return x.AllUpload
+	}
+	return false
+}
+
+type D6D9ReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedsInfoReq *D6D9FeedsReqBody `protobuf:"bytes,5,opt,name=feedsInfoReq,proto3" json:"feedsInfoReq,omitempty"`
+}
+
+func (x *D6D9ReqBody) Reset() {
+	*x = D6D9ReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D9ReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D9ReqBody) ProtoMessage() {}
+
+func (x *D6D9ReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D9ReqBody.ProtoReflect.Descriptor instead.
+func (*D6D9ReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *D6D9ReqBody) GetFeedsInfoReq() *D6D9FeedsReqBody {
+	if x != nil {
+		return x.FeedsInfoReq
+	}
+	return nil
+}
+
+type D6D9FeedsReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode     int64                     `protobuf:"varint,1,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AppId         int32                     `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	FeedsInfoList []*D6D9GroupFileFeedsInfo `protobuf:"bytes,3,rep,name=feedsInfoList,proto3" json:"feedsInfoList,omitempty"`
+	MultiSendSeq  int32                     `protobuf:"varint,4,opt,name=multiSendSeq,proto3" json:"multiSendSeq,omitempty"`
+}
+
+func (x *D6D9FeedsReqBody) Reset() {
+	*x = D6D9FeedsReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D9FeedsReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D9FeedsReqBody) ProtoMessage() {}
+
+func (x *D6D9FeedsReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D9FeedsReqBody.ProtoReflect.Descriptor instead.
+func (*D6D9FeedsReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *D6D9FeedsReqBody) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *D6D9FeedsReqBody) GetAppId() int32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *D6D9FeedsReqBody) GetFeedsInfoList() []*D6D9GroupFileFeedsInfo {
+	if x != nil {
+		return x.FeedsInfoList
+	}
+	return nil
+}
+
+func (x *D6D9FeedsReqBody) GetMultiSendSeq() int32 {
+	if x != nil {
+		return x.MultiSendSeq
+	}
+	return 0
+}
+
+type D6D9GroupFileFeedsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusId     int32  `protobuf:"varint,1,opt,name=busId,proto3" json:"busId,omitempty"`
+	FileId    string `protobuf:"bytes,2,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	MsgRandom int32  `protobuf:"varint,3,opt,name=msgRandom,proto3" json:"msgRandom,omitempty"`
+	Ext       []byte `protobuf:"bytes,4,opt,name=ext,proto3" json:"ext,omitempty"`
+	FeedFlag  int32  `protobuf:"varint,5,opt,name=feedFlag,proto3" json:"feedFlag,omitempty"`
+}
+
+func (x *D6D9GroupFileFeedsInfo) Reset() {
+	*x = D6D9GroupFileFeedsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D9GroupFileFeedsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D9GroupFileFeedsInfo) ProtoMessage() {}
+
+func (x *D6D9GroupFileFeedsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D9GroupFileFeedsInfo.ProtoReflect.Descriptor instead.
+func (*D6D9GroupFileFeedsInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *D6D9GroupFileFeedsInfo) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *D6D9GroupFileFeedsInfo) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *D6D9GroupFileFeedsInfo) GetMsgRandom() int32 {
+	if x != nil {
+		return x.MsgRandom
+	}
+	return 0
+}
+
+func (x *D6D9GroupFileFeedsInfo) GetExt() []byte {
+	if x != nil {
+		return x.Ext
+	}
+	return nil
+}
+
+func (x *D6D9GroupFileFeedsInfo) GetFeedFlag() int32 {
+	if x != nil {
+		return x.FeedFlag
+	}
+	return 0
+}
+
+type D6D9RspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedsInfoRsp *D6D9FeedsRspBody `protobuf:"bytes,5,opt,name=feedsInfoRsp,proto3" json:"feedsInfoRsp,omitempty"`
+}
+
+func (x *D6D9RspBody) Reset() {
+	*x = D6D9RspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D9RspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D9RspBody) ProtoMessage() {}
+
+func (x *D6D9RspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D9RspBody.ProtoReflect.Descriptor instead.
+func (*D6D9RspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *D6D9RspBody) GetFeedsInfoRsp() *D6D9FeedsRspBody {
+	if x != nil {
+		return x.FeedsInfoRsp
+	}
+	return nil
+}
+
+type D6D9FeedsRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode       int32  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg        string `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	ClientWording string `protobuf:"bytes,3,opt,name=clientWording,proto3" json:"clientWording,omitempty"`
+}
+
+func (x *D6D9FeedsRspBody) Reset() {
+	*x = D6D9FeedsRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D6D9FeedsRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D6D9FeedsRspBody) ProtoMessage() {}
+
+func (x *D6D9FeedsRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D6D9FeedsRspBody.ProtoReflect.Descriptor instead.
+func (*D6D9FeedsRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *D6D9FeedsRspBody) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *D6D9FeedsRspBody) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *D6D9FeedsRspBody) GetClientWording() string {
+	if x != nil {
+		return x.ClientWording
+	}
+	return ""
+}
+
+type GroupFileUploadExt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Unknown1 int32                 `protobuf:"varint,1,opt,name=unknown1,proto3" json:"unknown1,omitempty"`
+	Unknown2 int32                 `protobuf:"varint,2,opt,name=unknown2,proto3" json:"unknown2,omitempty"`
+	Unknown3 int32                 `protobuf:"varint,3,opt,name=unknown3,proto3" json:"unknown3,omitempty"`
+	Entry    *GroupFileUploadEntry `protobuf:"bytes,100,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GroupFileUploadExt) Reset() {
+	*x = GroupFileUploadExt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupFileUploadExt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupFileUploadExt) ProtoMessage() {}
+
+func (x *GroupFileUploadExt) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupFileUploadExt.ProtoReflect.Descriptor instead.
+func (*GroupFileUploadExt) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GroupFileUploadExt) GetUnknown1() int32 {
+	if x != nil {
+		return x.Unknown1
+	}
+	return 0
+}
+
+func (x *GroupFileUploadExt) GetUnknown2() int32 {
+	if x != nil {
+		return x.Unknown2
+	}
+	return 0
+}
+
+func (x *GroupFileUploadExt) GetUnknown3() int32 {
+	if x != nil {
+		return x.Unknown3
+	}
+	return 0
+}
+
+func (x *GroupFileUploadExt) GetEntry() *GroupFileUploadEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GroupFileUploadEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Business     *ExcitingBusiInfo     `protobuf:"bytes,100,opt,name=business,proto3" json:"business,omitempty"`
+	FileEntry    *ExcitingFileEntry    `protobuf:"bytes,200,opt,name=fileEntry,proto3" json:"fileEntry,omitempty"`
+	ClientInfo   *ExcitingClientInfo   `protobuf:"bytes,300,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
+	FileNameInfo *ExcitingFileNameInfo `protobuf:"bytes,400,opt,name=fileNameInfo,proto3" json:"fileNameInfo,omitempty"`
+	Host         *ExcitingHostConfig   `protobuf:"bytes,500,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *GroupFileUploadEntry) Reset() {
+	*x = GroupFileUploadEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupFileUploadEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupFileUploadEntry) ProtoMessage() {}
+
+func (x *GroupFileUploadEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupFileUploadEntry.ProtoReflect.Descriptor instead.
+func (*GroupFileUploadEntry) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GroupFileUploadEntry) GetBusiness() *ExcitingBusiInfo {
+	if x != nil {
+		return x.Business
+	}
+	return nil
+}
+
+func (x *GroupFileUploadEntry) GetFileEntry() *ExcitingFileEntry {
+	if x != nil {
+		return x.FileEntry
+	}
+	return nil
+}
+
+func (x *GroupFileUploadEntry) GetClientInfo() *ExcitingClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *GroupFileUploadEntry) GetFileNameInfo() *ExcitingFileNameInfo {
+	if x != nil {
+		return x.FileNameInfo
+	}
+	return nil
+}
+
+func (x *GroupFileUploadEntry) GetHost() *ExcitingHostConfig {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+type ExcitingBusiInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusId       int32 `protobuf:"varint,1,opt,name=busId,proto3" json:"busId,omitempty"`
+	SenderUin   int64 `protobuf:"varint,100,opt,name=senderUin,proto3" json:"senderUin,omitempty"`
+	ReceiverUin int64 `protobuf:"varint,200,opt,name=receiverUin,proto3" json:"receiverUin,omitempty"`
+	GroupCode   int64 `protobuf:"varint,400,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+}
+
+func (x *ExcitingBusiInfo) Reset() {
+	*x = ExcitingBusiInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingBusiInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingBusiInfo) ProtoMessage() {}
+
+func (x *ExcitingBusiInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingBusiInfo.ProtoReflect.Descriptor instead.
+func (*ExcitingBusiInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExcitingBusiInfo) GetBusId() int32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *ExcitingBusiInfo) GetSenderUin() int64 {
+	if x != nil {
+		return x.SenderUin
+	}
+	return 0
+}
+
+func (x *ExcitingBusiInfo) GetReceiverUin() int64 {
+	if x != nil {
+		return x.ReceiverUin
+	}
+	return 0
+}
+
+func (x *ExcitingBusiInfo) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+type ExcitingFileEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileSize  int64  `protobuf:"varint,100,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	Md5       []byte `protobuf:"bytes,200,opt,name=md5,proto3" json:"md5,omitempty"`
+	Sha1      []byte `protobuf:"bytes,300,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	FileId    string `protobuf:"bytes,600,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	UploadKey []byte `protobuf:"bytes,700,opt,name=uploadKey,proto3" json:"uploadKey,omitempty"`
+}
+
+func (x *ExcitingFileEntry) Reset() {
+	*x = ExcitingFileEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingFileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingFileEntry) ProtoMessage() {}
+
+func (x *ExcitingFileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingFileEntry.ProtoReflect.Descriptor instead.
+func (*ExcitingFileEntry) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExcitingFileEntry) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ExcitingFileEntry) GetMd5() []byte {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *ExcitingFileEntry) GetSha1() []byte {
+	if x != nil {
+		return x.Sha1
+	}
+	return nil
+}
+
+func (x *ExcitingFileEntry) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ExcitingFileEntry) GetUploadKey() []byte {
+	if x != nil {
+		return x.UploadKey
+	}
+	return nil
+}
+
+type ExcitingClientInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientType   int32  `protobuf:"varint,100,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	AppId        string `protobuf:"bytes,200,opt,name=appId,proto3" json:"appId,omitempty"`
+	TerminalType int32  `protobuf:"varint,300,opt,name=terminalType,proto3" json:"terminalType,omitempty"`
+	ClientVer    string `protobuf:"bytes,400,opt,name=clientVer,proto3" json:"clientVer,omitempty"`
+	Unknown      int32  `protobuf:"varint,600,opt,name=unknown,proto3" json:"unknown,omitempty"`
+}
+
+func (x *ExcitingClientInfo) Reset() {
+	*x = ExcitingClientInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingClientInfo) ProtoMessage() {}
+
+func (x *ExcitingClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingClientInfo.ProtoReflect.Descriptor instead.
+func (*ExcitingClientInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExcitingClientInfo) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *ExcitingClientInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ExcitingClientInfo) GetTerminalType() int32 {
+	if x != nil {
+		return x.TerminalType
+	}
+	return 0
+}
+
+func (x *ExcitingClientInfo) GetClientVer() string {
+	if x != nil {
+		return x.ClientVer
+	}
+	return ""
+}
+
+func (x *ExcitingClientInfo) GetUnknown() int32 {
+	if x != nil {
+		return x.Unknown
+	}
+	return 0
+}
+
+type ExcitingFileNameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName string `protobuf:"bytes,100,opt,name=fileName,proto3" json:"fileName,omitempty"`
+}
+
+func (x *ExcitingFileNameInfo) Reset() {
+	*x = ExcitingFileNameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingFileNameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingFileNameInfo) ProtoMessage() {}
+
+func (x *ExcitingFileNameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingFileNameInfo.ProtoReflect.Descriptor instead.
+func (*ExcitingFileNameInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExcitingFileNameInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ExcitingHostConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*ExcitingHostInfo `protobuf:"bytes,200,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *ExcitingHostConfig) Reset() {
+	*x = ExcitingHostConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingHostConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingHostConfig) ProtoMessage() {}
+
+func (x *ExcitingHostConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingHostConfig.ProtoReflect.Descriptor instead.
+func (*ExcitingHostConfig) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExcitingHostConfig) GetHosts() []*ExcitingHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type ExcitingHostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  *ExcitingUrl `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Port int32        `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *ExcitingHostInfo) Reset() {
+	*x = ExcitingHostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingHostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingHostInfo) ProtoMessage() {}
+
+func (x *ExcitingHostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingHostInfo.ProtoReflect.Descriptor instead.
+func (*ExcitingHostInfo) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExcitingHostInfo) GetUrl() *ExcitingUrl {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *ExcitingHostInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type ExcitingUrl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Unknown int32  `protobuf:"varint,1,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	Host    string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *ExcitingUrl) Reset() {
+	*x = ExcitingUrl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExcitingUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitingUrl) ProtoMessage() {}
+
+func (x *ExcitingUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitingUrl.ProtoReflect.Descriptor instead.
+func (*ExcitingUrl) Descriptor() ([]byte, []int) {
+	return file_oidb_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExcitingUrl) GetUnknown() int32 {
+	if x != nil {
+		return x.Unknown
+	}
+	return 0
+}
+
+func (x *ExcitingUrl) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+var File_oidb_proto protoreflect.FileDescriptor
+
+var file_oidb_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a,
+	0x0a, 0x4f, 0x49, 0x44, 0x42, 0x53, 0x53, 0x4f, 0x50, 0x6b, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x74, 0x0a, 0x0b, 0x44, 0x38, 0x41, 0x30, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x70,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x35, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6a, 0x0a, 0x0e, 0x44, 0x38, 0x41, 0x30, 0x4b,
+	0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x55, 0x69, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69, 0x63, 0x6b,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x70,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f,
+	0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x70, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x22, 0xc6,
+	0x01, 0x0a, 0x0b, 0x44, 0x38, 0x41, 0x30, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2e,
+	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35,
+	0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x44, 0x38, 0x41, 0x30, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x4b, 0x69, 0x63,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x22, 0xe3, 0x02, 0x0a, 0x0b, 0x44, 0x38, 0x46, 0x43,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x6c, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x33, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x44, 0x38, 0x46, 0x43,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65,
+	0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0d,
+	0x6d, 0x73, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6d, 0x73, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xbd, 0x01,
+	0x0a, 0x0b, 0x44, 0x38, 0x39, 0x41, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a,
+	0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x55, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x69, 0x6e, 0x12,
+	0x2c, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x22, 0xbc, 0x0d,
+	0x0a, 0x0d, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x74, 0x41, 0x64, 0x6d, 0x4e, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78,
+	0x74, 0x41, 0x64, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d,
+	0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x69, 0x6f, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x69, 0x6f, 0x53, 0x6b, 0x69, 0x6e,
+	0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x14, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x69, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d,
+	0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x69, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x12,
+	0x4f, 0x0a, 0x14, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x67, 0x75, 0x69,
+	0x64, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x47, 0x75, 0x69, 0x64,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x14, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x67, 0x75, 0x69, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x61, 0x64, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x03,
+	0x76, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x76, 0x61, 0x6c,
+	0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x12, 0x3b,
+	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6d, 0x73, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78,
+	0x74, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x54, 0x65, 0x78, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x6e,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x32, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x13,
+	0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x56, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61,
+	0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12,
+	0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x0d, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x65, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x69, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x14, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x12,
+	0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69,
+	0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67,
+	0x65, 0x78, 0x74, 0x33, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x6c, 0x61, 0x67, 0x65, 0x78, 0x74, 0x33, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x65, 0x78, 0x74, 0x33, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x65,
+	0x78, 0x74, 0x33, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x6e, 0x6f, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46,
+	0x6c, 0x61, 0x67, 0x18, 0x23, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6e, 0x6f, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x6e,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46,
+	0x6c, 0x61, 0x67, 0x18, 0x24, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6e, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x73, 0x67, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x6d, 0x73, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x73, 0x68, 0x75, 0x74, 0x75, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x42, 0x13, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x1a,
+	0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x65, 0x77, 0x47, 0x75, 0x69, 0x64,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6f,
+	0x6f, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x13,
+	0x44, 0x38, 0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x44, 0x38,
+	0x39, 0x41, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x6e, 0x67, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x6e, 0x67,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x6e, 0x67, 0x47, 0x65,
+	0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x49, 0x64, 0x22, 0x9b,
+	0x04, 0x0a, 0x0e, 0x44, 0x38, 0x46, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x75, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x69, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x61, 0x72,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x69,
+	0x62, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x72, 0x69, 0x62, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x69,
+	0x62, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x69, 0x63,
+	0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x44, 0x38, 0x46, 0x43, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6c,
+	0x65, 0x6d, 0x52, 0x0c, 0x72, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x52, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d,
+	0x52, 0x69, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x10,
+	0x44, 0x38, 0x46, 0x43, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x65, 0x6d,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x38,
+	0x46, 0x43, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x44, 0x38, 0x46, 0x43, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x76, 0x65, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x44, 0x45, 0x44, 0x33, 0x52, 0x65, 0x71, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65,
+	0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x69, 0x6f,
+	0x55, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x69, 0x6f, 0x55, 0x69,
+	0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x44, 0x37, 0x35, 0x38, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x62, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x44, 0x37, 0x35, 0x38, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x62, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64,
+	0x55, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x69,
+	0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x3b, 0x0a, 0x11, 0x44, 0x37, 0x35, 0x38, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x22, 0x85, 0x02, 0x0a,
+	0x0b, 0x44, 0x36, 0x44, 0x36, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0d,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3c,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x22, 0xed, 0x02, 0x0a, 0x15, 0x44, 0x36, 0x44, 0x36, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x68, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x68, 0x61, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68,
+	0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6d, 0x64, 0x35, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x44, 0x36, 0x44, 0x36, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x6f,
+	0x6f, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x75, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x6f, 0x6f, 0x6c,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x62, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x22, 0xa1, 0x01, 0x0a, 0x15, 0x44, 0x36, 0x44, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62,
+	0x75, 0x73, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x44, 0x36, 0x44, 0x36, 0x4d, 0x6f, 0x76,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x44,
+	0x36, 0x44, 0x36, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0d, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0d,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x44, 0x36, 0x44, 0x36, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73,
+	0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x9f, 0x03, 0x0a, 0x15, 0x44, 0x36, 0x44, 0x36, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x6e, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62,
+	0x75, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x6f, 0x6f, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x70, 0x4c, 0x61, 0x6e, 0x56, 0x34, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x4c, 0x61, 0x6e, 0x56, 0x34, 0x12, 0x24,
+	0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x4c, 0x61, 0x6e, 0x56, 0x36, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x4c,
+	0x61, 0x6e, 0x56, 0x36, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x6f, 0x72, 0x74, 0x22, 0xf1, 0x02, 0x0a, 0x17, 0x44, 0x36, 0x44, 0x36, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x68, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x68, 0x61, 0x33, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68,
+	0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6d, 0x64, 0x35, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x56, 0x61,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x56,
+	0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x61, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x61, 0x76, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x6f, 0x0a, 0x15, 0x44, 0x36, 0x44, 0x36,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x44, 0x36,
+	0x44, 0x36, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x44, 0x36, 0x44, 0x37, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44,
+	0x37, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x44, 0x36, 0x44, 0x37, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44, 0x37, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x22, 0x95, 0x01,
+	0x0a, 0x17, 0x44, 0x36, 0x44, 0x37, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x17, 0x44, 0x36, 0x44, 0x37, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x8f, 0x01, 0x0a, 0x17, 0x44, 0x36, 0x44, 0x37, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x65, 0x77, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x44, 0x36, 0x44, 0x37, 0x52, 0x73, 0x70, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x36,
+	0x44, 0x37, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70,
+	0x12, 0x3c, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x36, 0x44, 0x37,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x3c,
+	0x0a, 0x0f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x36, 0x44, 0x37, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x6b, 0x0a, 0x11,
+	0x44, 0x36, 0x44, 0x37, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x44, 0x36,
+	0x44, 0x38, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x66, 0x69, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x22, 0x8c, 0x03, 0x0a,
+	0x16, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x71, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x17, 0x44,
+	0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75,
+	0x73, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64,
+	0x22, 0x49, 0x0a, 0x13, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x0b,
+	0x44, 0x36, 0x44, 0x38, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x66,
+	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0f, 0x66,
+	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x12, 0x42,
+	0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6e, 0x74, 0x52, 0x73,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6e, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x73, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x44, 0x36, 0x44, 0x38,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x73, 0x70, 0x22, 0x91,
+	0x02, 0x0a, 0x16, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x36, 0x44, 0x38,
+	0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x69, 0x74,
+	0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e,
+	0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x44, 0x36, 0x44, 0x38, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x36, 0x44, 0x38, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x11, 0x44, 0x36, 0x44, 0x38, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x33, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x68, 0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12,
+	0x26, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x13, 0x44, 0x36, 0x44, 0x38,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x69, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x69,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x17,
+	0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x6f, 0x4d, 0x61,
+	0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x6f,
+	0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0xc9, 0x01,
+	0x0a, 0x13, 0x44, 0x36, 0x44, 0x38, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x73,
+	0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6c, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x6c, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x44, 0x0a, 0x0b, 0x44, 0x36, 0x44,
+	0x39, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x44, 0x36, 0x44, 0x39, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22,
+	0xa9, 0x01, 0x0a, 0x10, 0x44, 0x36, 0x44, 0x39, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x44, 0x36, 0x44, 0x39, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x22, 0x92, 0x01, 0x0a, 0x16,
+	0x44, 0x36, 0x44, 0x39, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67,
+	0x22, 0x44, 0x0a, 0x0b, 0x44, 0x36, 0x44, 0x39, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x35, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x36, 0x44, 0x39, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x22, 0x6a, 0x0a, 0x10, 0x44, 0x36, 0x44, 0x39, 0x46, 0x65,
+	0x65, 0x64, 0x73, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x32, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x33, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x33, 0x12, 0x2b, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x94, 0x02, 0x0a, 0x14, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x75, 0x73, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x78, 0x63,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0c, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x90, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0xf4, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x75,
+	0x73, 0x69, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x69, 0x6e, 0x12, 0x1d, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8f, 0x01, 0x0a,
+	0x11, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x11,
+	0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x64,
+	0x35, 0x12, 0x13, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x31, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x73, 0x68, 0x61, 0x31, 0x12, 0x17, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x18, 0xbc, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x22, 0xaa,
+	0x01, 0x0a, 0x12, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0c,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x18, 0x90,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0xd8, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x32, 0x0a, 0x14, 0x45,
+	0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3e, 0x0a, 0x12, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0xc8,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22,
+	0x46, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x45, 0x78, 0x63, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x45, 0x78, 0x63, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x3b, 0x6f, 0x69, 0x64, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oidb_proto_rawDescOnce sync.Once
+	file_oidb_proto_rawDescData = file_oidb_proto_rawDesc
+)
+
+func file_oidb_proto_rawDescGZIP() []byte {
+	file_oidb_proto_rawDescOnce.Do(func() {
+		file_oidb_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidb_proto_rawDescData)
+	})
+	return file_oidb_proto_rawDescData
+}
+
+var file_oidb_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_oidb_proto_goTypes = []interface{}{
+	(*OIDBSSOPkg)(nil),                 // 0: OIDBSSOPkg
+	(*D8A0RspBody)(nil),                // 1: D8A0RspBody
+	(*D8A0KickResult)(nil),             // 2: D8A0KickResult
+	(*D8A0KickMemberInfo)(nil),         // 3: D8A0KickMemberInfo
+	(*D8A0ReqBody)(nil),                // 4: D8A0ReqBody
+	(*D8FCReqBody)(nil),                // 5: D8FCReqBody
+	(*D89AReqBody)(nil),                // 6: D89AReqBody
+	(*D89AGroupinfo)(nil),              // 7: D89AGroupinfo
+	(*D89AGroupNewGuidelinesInfo)(nil), // 8: D89AGroupNewGuidelinesInfo
+	(*D89AGroupExInfoOnly)(nil),        // 9: D89AGroupExInfoOnly
+	(*D89AGroupGeoInfo)(nil),           // 10: D89AGroupGeoInfo
+	(*D8FCMemberInfo)(nil),             // 11: D8FCMemberInfo
+	(*D8FCCardNameElem)(nil),           // 12: D8FCCardNameElem
+	(*D8FCLevelName)(nil),              // 13: D8FCLevelName
+	(*D8FCClientInfo)(nil),             // 14: D8FCClientInfo
+	(*DED3ReqBody)(nil),                // 15: DED3ReqBody
+	(*D758ReqBody)(nil),                // 16: D758ReqBody
+	(*D758InviteUinInfo)(nil),          // 17: D758InviteUinInfo
+	(*D6D6ReqBody)(nil),                // 18: D6D6ReqBody
+	(*D6D6UploadFileReqBody)(nil),      // 19: D6D6UploadFileReqBody
+	(*D6D6DownloadFileReqBody)(nil),    // 20: D6D6DownloadFileReqBody
+	(*D6D6DeleteFileReqBody)(nil),      // 21: D6D6DeleteFileReqBody
+	(*D6D6MoveFileReqBody)(nil),        // 22: D6D6MoveFileReqBody
+	(*D6D6RspBody)(nil),                // 23: D6D6RspBody
+	(*D6D6UploadFileRspBody)(nil),      // 24: D6D6UploadFileRspBody
+	(*D6D6DownloadFileRspBody)(nil),    // 25: D6D6DownloadFileRspBody
+	(*D6D6DeleteFileRspBody)(nil),      // 26: D6D6DeleteFileRspBody
+	(*D6D6MoveFileRspBody)(nil),        // 27: D6D6MoveFileRspBody
+	(*D6D7ReqBody)(nil),                // 28: D6D7ReqBody
+	(*D6D7CreateFolderReqBody)(nil),    // 29: D6D7CreateFolderReqBody
+	(*D6D7DeleteFolderReqBody)(nil),    // 30: D6D7DeleteFolderReqBody
+	(*D6D7RenameFolderReqBody)(nil),    // 31: D6D7RenameFolderReqBody
+	(*D6D7RspBody)(nil),                // 32: D6D7RspBody
+	(*D6D7FolderRspBody)(nil),          // 33: D6D7FolderRspBody
+	(*D6D8ReqBody)(nil),                // 34: D6D8ReqBody
+	(*D6D8GetFileListReqBody)(nil),     // 35: D6D8GetFileListReqBody
+	(*D6D8GetFileCountReqBody)(nil),    // 36: D6D8GetFileCountReqBody
+	(*D6D8GetSpaceReqBody)(nil),        // 37: D6D8GetSpaceReqBody
+	(*D6D8RspBody)(nil),                // 38: D6D8RspBody
+	(*D6D8GetFileListRspBody)(nil),     // 39: D6D8GetFileListRspBody
+	(*D6D8FileListItem)(nil),           // 40: D6D8FileListItem
+	(*D6D8GroupFileInfo)(nil),          // 41: D6D8GroupFileInfo
+	(*D6D8GroupFolderInfo)(nil),        // 42: D6D8GroupFolderInfo
+	(*D6D8GetFileCountRspBody)(nil),    // 43: D6D8GetFileCountRspBody
+	(*D6D8GetSpaceRspBody)(nil),        // 44: D6D8GetSpaceRspBody
+	(*D6D9ReqBody)(nil),                // 45: D6D9ReqBody
+	(*D6D9FeedsReqBody)(nil),           // 46: D6D9FeedsReqBody
+	(*D6D9GroupFileFeedsInfo)(nil),     // 47: D6D9GroupFileFeedsInfo
+	(*D6D9RspBody)(nil),                // 48: D6D9RspBody
+	(*D6D9FeedsRspBody)(nil),           // 49: D6D9FeedsRspBody
+	(*GroupFileUploadExt)(nil),         // 50: GroupFileUploadExt
+	(*GroupFileUploadEntry)(nil),       // 51: GroupFileUploadEntry
+	(*ExcitingBusiInfo)(nil),           // 52: ExcitingBusiInfo
+	(*ExcitingFileEntry)(nil),          // 53: ExcitingFileEntry
+	(*ExcitingClientInfo)(nil),         // 54: ExcitingClientInfo
+	(*ExcitingFileNameInfo)(nil),       // 55: ExcitingFileNameInfo
+	(*ExcitingHostConfig)(nil),         // 56: ExcitingHostConfig
+	(*ExcitingHostInfo)(nil),           // 57: ExcitingHostInfo
+	(*ExcitingUrl)(nil),                // 58: ExcitingUrl
+}
+var file_oidb_proto_depIdxs = []int32{
+	2,  // 0: D8A0RspBody.msgKickResult:type_name -> D8A0KickResult
+	3,  // 1: D8A0ReqBody.msgKickList:type_name -> D8A0KickMemberInfo
+	11, // 2: D8FCReqBody.memLevelInfo:type_name -> D8FCMemberInfo
+	13, // 3: D8FCReqBody.levelName:type_name -> D8FCLevelName
+	14, // 4: D8FCReqBody.msgClientInfo:type_name -> D8FCClientInfo
+	7,  // 5: D89AReqBody.stGroupInfo:type_name -> D89AGroupinfo
+	8,  // 6: D89AGroupinfo.stGroupNewguidelines:type_name -> D89AGroupNewGuidelinesInfo
+	10, // 7: D89AGroupinfo.msgGroupGeoInfo:type_name -> D89AGroupGeoInfo
+	9,  // 8: D89AGroupinfo.stGroupExInfo:type_name -> D89AGroupExInfoOnly
+	12, // 9: D8FCMemberInfo.richCardName:type_name -> D8FCCardNameElem
+	17, // 10: D758ReqBody.beInvitedUinInfo:type_name -> D758InviteUinInfo
+	19, // 11: D6D6ReqBody.uploadFileReq:type_name -> D6D6UploadFileReqBody
+	20, // 12: D6D6ReqBody.downloadFileReq:type_name -> D6D6DownloadFileReqBody
+	21, // 13: D6D6ReqBody.deleteFileReq:type_name -> D6D6DeleteFileReqBody
+	22, // 14: D6D6ReqBody.moveFileReq:type_name -> D6D6MoveFileReqBody
+	24, // 15: D6D6RspBody.uploadFileRsp:type_name -> D6D6UploadFileRspBody
+	25, // 16: D6D6RspBody.downloadFileRsp:type_name -> D6D6DownloadFileRspBody
+	26, // 17: D6D6RspBody.deleteFileRsp:type_name -> D6D6DeleteFileRspBody
+	27, // 18: D6D6RspBody.moveFileRsp:type_name -> D6D6MoveFileRspBody
+	29, // 19: D6D7ReqBody.createFolderReq:type_name -> D6D7CreateFolderReqBody
+	30, // 20: D6D7ReqBody.deleteFolderReq:type_name -> D6D7DeleteFolderReqBody
+	31, // 21: D6D7ReqBody.renameFolderReq:type_name -> D6D7RenameFolderReqBody
+	33, // 22: D6D7RspBody.createFolderRsp:type_name -> D6D7FolderRspBody
+	33, // 23: D6D7RspBody.deleteFolderRsp:type_name -> D6D7FolderRspBody
+	33, // 24: D6D7RspBody.renameFolderRsp:type_name -> D6D7FolderRspBody
+	35, // 25: D6D8ReqBody.fileListInfoReq:type_name -> D6D8GetFileListReqBody
+	36, // 26: D6D8ReqBody.groupFileCntReq:type_name -> D6D8GetFileCountReqBody
+	37, // 27: D6D8ReqBody.groupSpaceReq:type_name -> D6D8GetSpaceReqBody
+	39, // 28: D6D8RspBody.fileListInfoRsp:type_name -> D6D8GetFileListRspBody
+	43, // 29: D6D8RspBody.groupFileCntRsp:type_name -> D6D8GetFileCountRspBody
+	44, // 30: D6D8RspBody.groupSpaceRsp:type_name -> D6D8GetSpaceRspBody
+	40, // 31: D6D8GetFileListRspBody.itemList:type_name -> D6D8FileListItem
+	42, // 32: D6D8FileListItem.folderInfo:type_name -> D6D8GroupFolderInfo
+	41, // 33: D6D8FileListItem.fileInfo:type_name -> D6D8GroupFileInfo
+	46, // 34: D6D9ReqBody.feedsInfoReq:type_name -> D6D9FeedsReqBody
+	47, // 35: D6D9FeedsReqBody.feedsInfoList:type_name -> D6D9GroupFileFeedsInfo
+	49, // 36: D6D9RspBody.feedsInfoRsp:type_name -> D6D9FeedsRspBody
+	51, // 37: GroupFileUploadExt.entry:type_name -> GroupFileUploadEntry
+	52, // 38: GroupFileUploadEntry.business:type_name -> ExcitingBusiInfo
+	53, // 39: GroupFileUploadEntry.fileEntry:type_name -> ExcitingFileEntry
+	54, // 40: GroupFileUploadEntry.clientInfo:type_name -> ExcitingClientInfo
+	55, // 41: GroupFileUploadEntry.fileNameInfo:type_name -> ExcitingFileNameInfo
+	56, // 42: GroupFileUploadEntry.host:type_name -> ExcitingHostConfig
+	57, // 43: ExcitingHostConfig.hosts:type_name -> ExcitingHostInfo
+	58, // 44: ExcitingHostInfo.url:type_name -> ExcitingUrl
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_oidb_proto_init() }
+func file_oidb_proto_init() {
+	if File_oidb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oidb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDBSSOPkg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8A0RspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8A0KickResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8A0KickMemberInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8A0ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8FCReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D89AReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D89AGroupinfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D89AGroupNewGuidelinesInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D89AGroupExInfoOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D89AGroupGeoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8FCMemberInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8FCCardNameElem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8FCLevelName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D8FCClientInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DED3ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D758ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D758InviteUinInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6UploadFileReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6DownloadFileReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6DeleteFileReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6MoveFileReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1777,8 +6129,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8A0RspBody); i {
+		file_oidb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6RspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,8 +6141,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8A0KickResult); i {
+		file_oidb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6UploadFileRspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,8 +6153,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8A0KickMemberInfo); i {
+		file_oidb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6DownloadFileRspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,8 +6165,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8A0ReqBody); i {
+		file_oidb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6DeleteFileRspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1825,8 +6177,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8FCReqBody); i {
+		file_oidb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D6MoveFileRspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1837,8 +6189,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D89AReqBody); i {
+		file_oidb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7ReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1849,8 +6201,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D89AGroupinfo); i {
+		file_oidb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7CreateFolderReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1861,8 +6213,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D89AGroupNewGuidelinesInfo); i {
+		file_oidb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7DeleteFolderReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1873,8 +6225,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D89AGroupExInfoOnly); i {
+		file_oidb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7RenameFolderReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1885,8 +6237,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D89AGroupGeoInfo); i {
+		file_oidb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7RspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1897,8 +6249,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8FCMemberInfo); i {
+		file_oidb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D7FolderRspBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1909,8 +6261,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8FCCardNameElem); i {
+		file_oidb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8ReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1921,8 +6273,8 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8FCLevelName); i {
+		file_oidb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetFileListReqBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,8 +6285,272 @@ func file_oidb_proto_init() {
 				return nil
 			}
 		}
-		file_oidb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*D8FCClientInfo); i {
+		file_oidb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetFileCountReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetSpaceReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8RspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetFileListRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8FileListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GroupFileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GroupFolderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetFileCountRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D8GetSpaceRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D9ReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D9FeedsReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D9GroupFileFeedsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D9RspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D6D9FeedsRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupFileUploadExt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupFileUploadEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingBusiInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingFileEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingClientInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingFileNameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingHostConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingHostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcitingUrl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1948,6 +6564,8 @@ func file_oidb_proto_init() {
 	}
 	file_oidb_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*D89AGroupinfo_Val)(nil),
+		(*D89AGroupinfo_AppPrivilegeFlagVal)(nil),
+		(*D89AGroupinfo_AllowMemberInviteVal)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1955,7 +6573,7 @@ func file_oidb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oidb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
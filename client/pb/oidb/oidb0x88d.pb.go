@@ -0,0 +1,872 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.11.4
+// source: oidb0x88d.proto
+
+package oidb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type D88DReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId           *uint32         `protobuf:"varint,1,opt,name=appId" json:"appId,omitempty"`
+	ReqGroupInfo    []*ReqGroupInfo `protobuf:"bytes,2,rep,name=reqGroupInfo" json:"reqGroupInfo,omitempty"`
+	PcClientVersion *uint32         `protobuf:"varint,3,opt,name=pcClientVersion" json:"pcClientVersion,omitempty"`
+}
+
+func (x *D88DReqBody) Reset() {
+	*x = D88DReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D88DReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D88DReqBody) ProtoMessage() {}
+
+func (x *D88DReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D88DReqBody.ProtoReflect.Descriptor instead.
+func (*D88DReqBody) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *D88DReqBody) GetAppId() uint32 {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return 0
+}
+
+func (x *D88DReqBody) GetReqGroupInfo() []*ReqGroupInfo {
+	if x != nil {
+		return x.ReqGroupInfo
+	}
+	return nil
+}
+
+func (x *D88DReqBody) GetPcClientVersion() uint32 {
+	if x != nil && x.PcClientVersion != nil {
+		return *x.PcClientVersion
+	}
+	return 0
+}
+
+type ReqGroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode            *uint64        `protobuf:"varint,1,opt,name=groupCode" json:"groupCode,omitempty"`
+	Stgroupinfo          *D88DGroupInfo `protobuf:"bytes,2,opt,name=stgroupinfo" json:"stgroupinfo,omitempty"`
+	LastGetGroupNameTime *uint32        `protobuf:"varint,3,opt,name=lastGetGroupNameTime" json:"lastGetGroupNameTime,omitempty"`
+}
+
+func (x *ReqGroupInfo) Reset() {
+	*x = ReqGroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGroupInfo) ProtoMessage() {}
+
+func (x *ReqGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGroupInfo.ProtoReflect.Descriptor instead.
+func (*ReqGroupInfo) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReqGroupInfo) GetGroupCode() uint64 {
+	if x != nil && x.GroupCode != nil {
+		return *x.GroupCode
+	}
+	return 0
+}
+
+func (x *ReqGroupInfo) GetStgroupinfo() *D88DGroupInfo {
+	if x != nil {
+		return x.Stgroupinfo
+	}
+	return nil
+}
+
+func (x *ReqGroupInfo) GetLastGetGroupNameTime() uint32 {
+	if x != nil && x.LastGetGroupNameTime != nil {
+		return *x.LastGetGroupNameTime
+	}
+	return 0
+}
+
+type D88DRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RspGroupInfo []*RspGroupInfo `protobuf:"bytes,1,rep,name=rspGroupInfo" json:"rspGroupInfo,omitempty"`
+	StrErrorInfo []byte          `protobuf:"bytes,2,opt,name=strErrorInfo" json:"strErrorInfo,omitempty"`
+}
+
+func (x *D88DRspBody) Reset() {
+	*x = D88DRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D88DRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D88DRspBody) ProtoMessage() {}
+
+func (x *D88DRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D88DRspBody.ProtoReflect.Descriptor instead.
+func (*D88DRspBody) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *D88DRspBody) GetRspGroupInfo() []*RspGroupInfo {
+	if x != nil {
+		return x.RspGroupInfo
+	}
+	return nil
+}
+
+func (x *D88DRspBody) GetStrErrorInfo() []byte {
+	if x != nil {
+		return x.StrErrorInfo
+	}
+	return nil
+}
+
+type RspGroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupCode *uint64        `protobuf:"varint,1,opt,name=groupCode" json:"groupCode,omitempty"`
+	Result    *uint32        `protobuf:"varint,2,opt,name=result" json:"result,omitempty"`
+	GroupInfo *D88DGroupInfo `protobuf:"bytes,3,opt,name=groupInfo" json:"groupInfo,omitempty"`
+}
+
+func (x *RspGroupInfo) Reset() {
+	*x = RspGroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RspGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RspGroupInfo) ProtoMessage() {}
+
+func (x *RspGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RspGroupInfo.ProtoReflect.Descriptor instead.
+func (*RspGroupInfo) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RspGroupInfo) GetGroupCode() uint64 {
+	if x != nil && x.GroupCode != nil {
+		return *x.GroupCode
+	}
+	return 0
+}
+
+func (x *RspGroupInfo) GetResult() uint32 {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return 0
+}
+
+func (x *RspGroupInfo) GetGroupInfo() *D88DGroupInfo {
+	if x != nil {
+		return x.GroupInfo
+	}
+	return nil
+}
+
+type D88DGroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupOwner         *uint64           `protobuf:"varint,1,opt,name=groupOwner" json:"groupOwner,omitempty"`
+	GroupCreateTime    *uint32           `protobuf:"varint,2,opt,name=groupCreateTime" json:"groupCreateTime,omitempty"`
+	GroupFlag          *uint32           `protobuf:"varint,3,opt,name=groupFlag" json:"groupFlag,omitempty"`
+	GroupFlagExt       *uint32           `protobuf:"varint,4,opt,name=groupFlagExt" json:"groupFlagExt,omitempty"`
+	GroupMemberMaxNum  *uint32           `protobuf:"varint,5,opt,name=groupMemberMaxNum" json:"groupMemberMaxNum,omitempty"`
+	GroupMemberNum     *uint32           `protobuf:"varint,6,opt,name=groupMemberNum" json:"groupMemberNum,omitempty"`
+	GroupOption        *uint32           `protobuf:"varint,7,opt,name=groupOption" json:"groupOption,omitempty"`
+	GroupClassExt      *uint32           `protobuf:"varint,8,opt,name=groupClassExt" json:"groupClassExt,omitempty"`
+	GroupSpecialClass  *uint32           `protobuf:"varint,9,opt,name=groupSpecialClass" json:"groupSpecialClass,omitempty"`
+	GroupLevel         *uint32           `protobuf:"varint,10,opt,name=groupLevel" json:"groupLevel,omitempty"`
+	GroupFace          *uint32           `protobuf:"varint,11,opt,name=groupFace" json:"groupFace,omitempty"`
+	GroupDefaultPage   *uint32           `protobuf:"varint,12,opt,name=groupDefaultPage" json:"groupDefaultPage,omitempty"`
+	GroupInfoSeq       *uint32           `protobuf:"varint,13,opt,name=groupInfoSeq" json:"groupInfoSeq,omitempty"`
+	GroupRoamingTime   *uint32           `protobuf:"varint,14,opt,name=groupRoamingTime" json:"groupRoamingTime,omitempty"`
+	GroupName          []byte            `protobuf:"bytes,15,opt,name=groupName" json:"groupName,omitempty"`
+	GroupMemo          []byte            `protobuf:"bytes,16,opt,name=groupMemo" json:"groupMemo,omitempty"`
+	IngGroupFingerMemo []byte            `protobuf:"bytes,17,opt,name=ingGroupFingerMemo" json:"ingGroupFingerMemo,omitempty"`
+	IngGroupClassText  []byte            `protobuf:"bytes,18,opt,name=ingGroupClassText" json:"ingGroupClassText,omitempty"`
+	GroupExtraAdmNum   *uint32           `protobuf:"varint,20,opt,name=groupExtraAdmNum" json:"groupExtraAdmNum,omitempty"`
+	GroupUin           *uint64           `protobuf:"varint,21,opt,name=groupUin" json:"groupUin,omitempty"`
+	GroupCurMsgSeq     *uint32           `protobuf:"varint,22,opt,name=groupCurMsgSeq" json:"groupCurMsgSeq,omitempty"`
+	GroupLastMsgTime   *uint32           `protobuf:"varint,23,opt,name=groupLastMsgTime" json:"groupLastMsgTime,omitempty"`
+	IngGroupQuestion   []byte            `protobuf:"bytes,24,opt,name=ingGroupQuestion" json:"ingGroupQuestion,omitempty"`
+	IngGroupAnswer     []byte            `protobuf:"bytes,25,opt,name=ingGroupAnswer" json:"ingGroupAnswer,omitempty"`
+	GroupGeoInfo       *D88DGroupGeoInfo `protobuf:"bytes,42,opt,name=groupGeoInfo" json:"groupGeoInfo,omitempty"`
+	AppPrivilegeFlag   *uint32           `protobuf:"varint,56,opt,name=appPrivilegeFlag" json:"appPrivilegeFlag,omitempty"`
+	GroupFlagext3      *uint32           `protobuf:"varint,77,opt,name=groupFlagext3" json:"groupFlagext3,omitempty"`
+}
+
+func (x *D88DGroupInfo) Reset() {
+	*x = D88DGroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D88DGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D88DGroupInfo) ProtoMessage() {}
+
+func (x *D88DGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D88DGroupInfo.ProtoReflect.Descriptor instead.
+func (*D88DGroupInfo) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *D88DGroupInfo) GetGroupOwner() uint64 {
+	if x != nil && x.GroupOwner != nil {
+		return *x.GroupOwner
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupCreateTime() uint32 {
+	if x != nil && x.GroupCreateTime != nil {
+		return *x.GroupCreateTime
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupFlag() uint32 {
+	if x != nil && x.GroupFlag != nil {
+		return *x.GroupFlag
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupFlagExt() uint32 {
+	if x != nil && x.GroupFlagExt != nil {
+		return *x.GroupFlagExt
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupMemberMaxNum() uint32 {
+	if x != nil && x.GroupMemberMaxNum != nil {
+		return *x.GroupMemberMaxNum
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupMemberNum() uint32 {
+	if x != nil && x.GroupMemberNum != nil {
+		return *x.GroupMemberNum
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupOption() uint32 {
+	if x != nil && x.GroupOption != nil {
+		return *x.GroupOption
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupClassExt() uint32 {
+	if x != nil && x.GroupClassExt != nil {
+		return *x.GroupClassExt
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupSpecialClass() uint32 {
+	if x != nil && x.GroupSpecialClass != nil {
+		return *x.GroupSpecialClass
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupLevel() uint32 {
+	if x != nil && x.GroupLevel != nil {
+		return *x.GroupLevel
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupFace() uint32 {
+	if x != nil && x.GroupFace != nil {
+		return *x.GroupFace
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupDefaultPage() uint32 {
+	if x != nil && x.GroupDefaultPage != nil {
+		return *x.GroupDefaultPage
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupInfoSeq() uint32 {
+	if x != nil && x.GroupInfoSeq != nil {
+		return *x.GroupInfoSeq
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupRoamingTime() uint32 {
+	if x != nil && x.GroupRoamingTime != nil {
+		return *x.GroupRoamingTime
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupName() []byte {
+	if x != nil {
+		return x.GroupName
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetGroupMemo() []byte {
+	if x != nil {
+		return x.GroupMemo
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetIngGroupFingerMemo() []byte {
+	if x != nil {
+		return x.IngGroupFingerMemo
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetIngGroupClassText() []byte {
+	if x != nil {
+		return x.IngGroupClassText
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetGroupExtraAdmNum() uint32 {
+	if x != nil && x.GroupExtraAdmNum != nil {
+		return *x.GroupExtraAdmNum
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupUin() uint64 {
+	if x != nil && x.GroupUin != nil {
+		return *x.GroupUin
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupCurMsgSeq() uint32 {
+	if x != nil && x.GroupCurMsgSeq != nil {
+		return *x.GroupCurMsgSeq
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupLastMsgTime() uint32 {
+	if x != nil && x.GroupLastMsgTime != nil {
+		return *x.GroupLastMsgTime
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetIngGroupQuestion() []byte {
+	if x != nil {
+		return x.IngGroupQuestion
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetIngGroupAnswer() []byte {
+	if x != nil {
+		return x.IngGroupAnswer
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetGroupGeoInfo() *D88DGroupGeoInfo {
+	if x != nil {
+		return x.GroupGeoInfo
+	}
+	return nil
+}
+
+func (x *D88DGroupInfo) GetAppPrivilegeFlag() uint32 {
+	if x != nil && x.AppPrivilegeFlag != nil {
+		return *x.AppPrivilegeFlag
+	}
+	return 0
+}
+
+func (x *D88DGroupInfo) GetGroupFlagext3() uint32 {
+	if x != nil && x.GroupFlagext3 != nil {
+		return *x.GroupFlagext3
+	}
+	return 0
+}
+
+type D88DGroupGeoInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owneruin   *uint64 `protobuf:"varint,1,opt,name=owneruin" json:"owneruin,omitempty"`
+	Settime    *uint32 `protobuf:"varint,2,opt,name=settime" json:"settime,omitempty"`
+	Cityid     *uint32 `protobuf:"varint,3,opt,name=cityid" json:"cityid,omitempty"`
+	Longitude  *int64  `protobuf:"varint,4,opt,name=longitude" json:"longitude,omitempty"`
+	Latitude   *int64  `protobuf:"varint,5,opt,name=latitude" json:"latitude,omitempty"`
+	Geocontent []byte  `protobuf:"bytes,6,opt,name=geocontent" json:"geocontent,omitempty"`
+	PoiId      *uint64 `protobuf:"varint,7,opt,name=poiId" json:"poiId,omitempty"`
+}
+
+func (x *D88DGroupGeoInfo) Reset() {
+	*x = D88DGroupGeoInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidb0x88d_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *D88DGroupGeoInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*D88DGroupGeoInfo) ProtoMessage() {}
+
+func (x *D88DGroupGeoInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_oidb0x88d_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use D88DGroupGeoInfo.ProtoReflect.Descriptor instead.
+func (*D88DGroupGeoInfo) Descriptor() ([]byte, []int) {
+	return file_oidb0x88d_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *D88DGroupGeoInfo) GetOwneruin() uint64 {
+	if x != nil && x.Owneruin != nil {
+		return *x.Owneruin
+	}
+	return 0
+}
+
+func (x *D88DGroupGeoInfo) GetSettime() uint32 {
+	if x != nil && x.Settime != nil {
+		return *x.Settime
+	}
+	return 0
+}
+
+func (x *D88DGroupGeoInfo) GetCityid() uint32 {
+	if x != nil && x.Cityid != nil {
+		return *x.Cityid
+	}
+	return 0
+}
+
+func (x *D88DGroupGeoInfo) GetLongitude() int64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *D88DGroupGeoInfo) GetLatitude() int64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *D88DGroupGeoInfo) GetGeocontent() []byte {
+	if x != nil {
+		return x.Geocontent
+	}
+	return nil
+}
+
+func (x *D88DGroupGeoInfo) GetPoiId() uint64 {
+	if x != nil && x.PoiId != nil {
+		return *x.PoiId
+	}
+	return 0
+}
+
+var File_oidb0x88d_proto protoreflect.FileDescriptor
+
+var file_oidb0x88d_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6f, 0x69, 0x64, 0x62, 0x30, 0x78, 0x38, 0x38, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x44, 0x38, 0x38, 0x44, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x52, 0x65, 0x71, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65,
+	0x71, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x63,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x63, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x44, 0x38, 0x38, 0x44, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x0b, 0x44, 0x38, 0x38,
+	0x44, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x73, 0x70, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x52, 0x73, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72,
+	0x73, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x74, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x72, 0x0a, 0x0c, 0x52, 0x73, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x44, 0x38, 0x38, 0x44, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0xb4, 0x08, 0x0a, 0x0d, 0x44, 0x38, 0x38, 0x44, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x45, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x45, 0x78,
+	0x74, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x12,
+	0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x75,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45, 0x78, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x67, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x4d,
+	0x65, 0x6d, 0x6f, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x54, 0x65, 0x78, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x74, 0x72, 0x61, 0x41,
+	0x64, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x45, 0x78, 0x74, 0x72, 0x61, 0x41, 0x64, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x69, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x75, 0x72, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x71, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x75, 0x72, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x71, 0x12, 0x2a, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x44, 0x38, 0x38, 0x44, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x50,
+	0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x38, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x61, 0x70, 0x70, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x61,
+	0x67, 0x65, 0x78, 0x74, 0x33, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x65, 0x78, 0x74, 0x33, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x44,
+	0x38, 0x38, 0x44, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x65, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x75, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x75, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x65, 0x6f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x49, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x49, 0x64, 0x42, 0x08, 0x5a,
+	0x06, 0x2e, 0x3b, 0x6f, 0x69, 0x64, 0x62,
+}
+
+var (
+	file_oidb0x88d_proto_rawDescOnce sync.Once
+	file_oidb0x88d_proto_rawDescData = file_oidb0x88d_proto_rawDesc
+)
+
+func file_oidb0x88d_proto_rawDescGZIP() []byte {
+	file_oidb0x88d_proto_rawDescOnce.Do(func() {
+		file_oidb0x88d_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidb0x88d_proto_rawDescData)
+	})
+	return file_oidb0x88d_proto_rawDescData
+}
+
+var file_oidb0x88d_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_oidb0x88d_proto_goTypes = []interface{}{
+	(*D88DReqBody)(nil),      // 0: D88DReqBody
+	(*ReqGroupInfo)(nil),     // 1: ReqGroupInfo
+	(*D88DRspBody)(nil),      // 2: D88DRspBody
+	(*RspGroupInfo)(nil),     // 3: RspGroupInfo
+	(*D88DGroupInfo)(nil),    // 4: D88DGroupInfo
+	(*D88DGroupGeoInfo)(nil), // 5: D88DGroupGeoInfo
+}
+var file_oidb0x88d_proto_depIdxs = []int32{
+	1, // 0: D88DReqBody.reqGroupInfo:type_name -> ReqGroupInfo
+	4, // 1: ReqGroupInfo.stgroupinfo:type_name -> D88DGroupInfo
+	3, // 2: D88DRspBody.rspGroupInfo:type_name -> RspGroupInfo
+	4, // 3: RspGroupInfo.groupInfo:type_name -> D88DGroupInfo
+	5, // 4: D88DGroupInfo.groupGeoInfo:type_name -> D88DGroupGeoInfo
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_oidb0x88d_proto_init() }
+func file_oidb0x88d_proto_init() {
+	if File_oidb0x88d_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oidb0x88d_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D88DReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb0x88d_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGroupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb0x88d_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D88DRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb0x88d_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RspGroupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb0x88d_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D88DGroupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidb0x88d_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*D88DGroupGeoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oidb0x88d_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_oidb0x88d_proto_goTypes,
+		DependencyIndexes: file_oidb0x88d_proto_depIdxs,
+		MessageInfos:      file_oidb0x88d_proto_msgTypes,
+	}.Build()
+	File_oidb0x88d_proto = out.File
+	file_oidb0x88d_proto_rawDesc = nil
+	file_oidb0x88d_proto_goTypes = nil
+	file_oidb0x88d_proto_depIdxs = nil
+}
@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.11.4
+// source: shortvideo.proto
+
+package pttcenter
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ShortVideoReqBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd                      int32                     `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Seq                      int32                     `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	PttShortVideoUploadReq   *PttShortVideoUploadReq   `protobuf:"bytes,3,opt,name=pttShortVideoUploadReq,proto3" json:"pttShortVideoUploadReq,omitempty"`
+	PttShortVideoDownloadReq *PttShortVideoDownloadReq `protobuf:"bytes,4,opt,name=pttShortVideoDownloadReq,proto3" json:"pttShortVideoDownloadReq,omitempty"`
+}
+
+func (x *ShortVideoReqBody) Reset() {
+	*x = ShortVideoReqBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortVideoReqBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortVideoReqBody) ProtoMessage() {}
+
+func (x *ShortVideoReqBody) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortVideoReqBody.ProtoReflect.Descriptor instead.
+func (*ShortVideoReqBody) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShortVideoReqBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *ShortVideoReqBody) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ShortVideoReqBody) GetPttShortVideoUploadReq() *PttShortVideoUploadReq {
+	if x != nil {
+		return x.PttShortVideoUploadReq
+	}
+	return nil
+}
+
+func (x *ShortVideoReqBody) GetPttShortVideoDownloadReq() *PttShortVideoDownloadReq {
+	if x != nil {
+		return x.PttShortVideoDownloadReq
+	}
+	return nil
+}
+
+type ShortVideoRspBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd                      int32                      `protobuf:"varint,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Seq                      int32                      `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	PttShortVideoUploadRsp   *PttShortVideoUploadResp   `protobuf:"bytes,3,opt,name=pttShortVideoUploadRsp,proto3" json:"pttShortVideoUploadRsp,omitempty"`
+	PttShortVideoDownloadRsp *PttShortVideoDownloadResp `protobuf:"bytes,4,opt,name=pttShortVideoDownloadRsp,proto3" json:"pttShortVideoDownloadRsp,omitempty"`
+}
+
+func (x *ShortVideoRspBody) Reset() {
+	*x = ShortVideoRspBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortVideoRspBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortVideoRspBody) ProtoMessage() {}
+
+func (x *ShortVideoRspBody) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortVideoRspBody.ProtoReflect.Descriptor instead.
+func (*ShortVideoRspBody) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShortVideoRspBody) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *ShortVideoRspBody) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ShortVideoRspBody) GetPttShortVideoUploadRsp() *PttShortVideoUploadResp {
+	if x != nil {
+		return x.PttShortVideoUploadRsp
+	}
+	return nil
+}
+
+func (x *ShortVideoRspBody) GetPttShortVideoDownloadRsp() *PttShortVideoDownloadResp {
+	if x != nil {
+		return x.PttShortVideoDownloadRsp
+	}
+	return nil
+}
+
+type PttShortVideoUploadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fromuin              int64                  `protobuf:"varint,1,opt,name=fromuin,proto3" json:"fromuin,omitempty"`
+	Touin                int64                  `protobuf:"varint,2,opt,name=touin,proto3" json:"touin,omitempty"`
+	ChatType             int32                  `protobuf:"varint,3,opt,name=chatType,proto3" json:"chatType,omitempty"`
+	ClientType           int32                  `protobuf:"varint,4,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	Info                 *PttShortVideoFileInfo `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	GroupCode            int64                  `protobuf:"varint,6,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AgentType            int32                  `protobuf:"varint,7,opt,name=agentType,proto3" json:"agentType,omitempty"`
+	BusinessType         int32                  `protobuf:"varint,8,opt,name=businessType,proto3" json:"businessType,omitempty"`
+	FlagSupportLargeSize int32                  `protobuf:"varint,9,opt,name=flagSupportLargeSize,proto3" json:"flagSupportLargeSize,omitempty"`
+}
+
+func (x *PttShortVideoUploadReq) Reset() {
+	*x = PttShortVideoUploadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoUploadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoUploadReq) ProtoMessage() {}
+
+func (x *PttShortVideoUploadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoUploadReq.ProtoReflect.Descriptor instead.
+func (*PttShortVideoUploadReq) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PttShortVideoUploadReq) GetFromuin() int64 {
+	if x != nil {
+		return x.Fromuin
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetTouin() int64 {
+	if x != nil {
+		return x.Touin
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetChatType() int32 {
+	if x != nil {
+		return x.ChatType
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetInfo() *PttShortVideoFileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadReq) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetAgentType() int32 {
+	if x != nil {
+		return x.AgentType
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetBusinessType() int32 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadReq) GetFlagSupportLargeSize() int32 {
+	if x != nil {
+		return x.FlagSupportLargeSize
+	}
+	return 0
+}
+
+type PttShortVideoFileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName      string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileMd5       []byte `protobuf:"bytes,2,opt,name=fileMd5,proto3" json:"fileMd5,omitempty"`
+	ThumbFileMd5  []byte `protobuf:"bytes,3,opt,name=thumbFileMd5,proto3" json:"thumbFileMd5,omitempty"`
+	FileSize      int64  `protobuf:"varint,4,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	FileResLength int32  `protobuf:"varint,5,opt,name=fileResLength,proto3" json:"fileResLength,omitempty"`
+	FileResWidth  int32  `protobuf:"varint,6,opt,name=fileResWidth,proto3" json:"fileResWidth,omitempty"`
+	FileFormat    int32  `protobuf:"varint,7,opt,name=fileFormat,proto3" json:"fileFormat,omitempty"`
+	FileTime      int32  `protobuf:"varint,8,opt,name=fileTime,proto3" json:"fileTime,omitempty"`
+	ThumbFileSize int64  `protobuf:"varint,9,opt,name=thumbFileSize,proto3" json:"thumbFileSize,omitempty"`
+}
+
+func (x *PttShortVideoFileInfo) Reset() {
+	*x = PttShortVideoFileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoFileInfo) ProtoMessage() {}
+
+func (x *PttShortVideoFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoFileInfo.ProtoReflect.Descriptor instead.
+func (*PttShortVideoFileInfo) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PttShortVideoFileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PttShortVideoFileInfo) GetFileMd5() []byte {
+	if x != nil {
+		return x.FileMd5
+	}
+	return nil
+}
+
+func (x *PttShortVideoFileInfo) GetThumbFileMd5() []byte {
+	if x != nil {
+		return x.ThumbFileMd5
+	}
+	return nil
+}
+
+func (x *PttShortVideoFileInfo) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *PttShortVideoFileInfo) GetFileResLength() int32 {
+	if x != nil {
+		return x.FileResLength
+	}
+	return 0
+}
+
+func (x *PttShortVideoFileInfo) GetFileResWidth() int32 {
+	if x != nil {
+		return x.FileResWidth
+	}
+	return 0
+}
+
+func (x *PttShortVideoFileInfo) GetFileFormat() int32 {
+	if x != nil {
+		return x.FileFormat
+	}
+	return 0
+}
+
+func (x *PttShortVideoFileInfo) GetFileTime() int32 {
+	if x != nil {
+		return x.FileTime
+	}
+	return 0
+}
+
+func (x *PttShortVideoFileInfo) GetThumbFileSize() int64 {
+	if x != nil {
+		return x.ThumbFileSize
+	}
+	return 0
+}
+
+type PttShortVideoUploadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode                      int32                  `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg                       string                 `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	SameAreaOutAddr              []*PttShortVideoIpList `protobuf:"bytes,3,rep,name=sameAreaOutAddr,proto3" json:"sameAreaOutAddr,omitempty"`
+	DiffAreaOutAddr              []*PttShortVideoIpList `protobuf:"bytes,4,rep,name=diffAreaOutAddr,proto3" json:"diffAreaOutAddr,omitempty"`
+	FileId                       string                 `protobuf:"bytes,5,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	Ukey                         []byte                 `protobuf:"bytes,6,opt,name=ukey,proto3" json:"ukey,omitempty"`
+	FileExist                    int32                  `protobuf:"varint,7,opt,name=fileExist,proto3" json:"fileExist,omitempty"`
+	SameAreaInnerAddr            []*PttShortVideoIpList `protobuf:"bytes,8,rep,name=sameAreaInnerAddr,proto3" json:"sameAreaInnerAddr,omitempty"`
+	DiffAreaInnerAddr            []*PttShortVideoIpList `protobuf:"bytes,9,rep,name=diffAreaInnerAddr,proto3" json:"diffAreaInnerAddr,omitempty"`
+	DataHoleAddr                 []*PttShortVideoIpList `protobuf:"bytes,10,rep,name=dataHoleAddr,proto3" json:"dataHoleAddr,omitempty"`
+	IsHotFile                    int32                  `protobuf:"varint,11,opt,name=isHotFile,proto3" json:"isHotFile,omitempty"`
+	LongVideoCarryWatchPointType int32                  `protobuf:"varint,12,opt,name=longVideoCarryWatchPointType,proto3" json:"longVideoCarryWatchPointType,omitempty"`
+	DataAreaAddr                 []*PttShortVideoIpList `protobuf:"bytes,13,rep,name=dataAreaAddr,proto3" json:"dataAreaAddr,omitempty"`
+}
+
+func (x *PttShortVideoUploadResp) Reset() {
+	*x = PttShortVideoUploadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoUploadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoUploadResp) ProtoMessage() {}
+
+func (x *PttShortVideoUploadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoUploadResp.ProtoReflect.Descriptor instead.
+func (*PttShortVideoUploadResp) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PttShortVideoUploadResp) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadResp) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *PttShortVideoUploadResp) GetSameAreaOutAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.SameAreaOutAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetDiffAreaOutAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DiffAreaOutAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *PttShortVideoUploadResp) GetUkey() []byte {
+	if x != nil {
+		return x.Ukey
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetFileExist() int32 {
+	if x != nil {
+		return x.FileExist
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadResp) GetSameAreaInnerAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.SameAreaInnerAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetDiffAreaInnerAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DiffAreaInnerAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetDataHoleAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DataHoleAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoUploadResp) GetIsHotFile() int32 {
+	if x != nil {
+		return x.IsHotFile
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadResp) GetLongVideoCarryWatchPointType() int32 {
+	if x != nil {
+		return x.LongVideoCarryWatchPointType
+	}
+	return 0
+}
+
+func (x *PttShortVideoUploadResp) GetDataAreaAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DataAreaAddr
+	}
+	return nil
+}
+
+type PttShortVideoDownloadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUin      int64  `protobuf:"varint,1,opt,name=fromUin,proto3" json:"fromUin,omitempty"`
+	ToUin        int64  `protobuf:"varint,2,opt,name=toUin,proto3" json:"toUin,omitempty"`
+	ChatType     int32  `protobuf:"varint,3,opt,name=chatType,proto3" json:"chatType,omitempty"`
+	ClientType   int32  `protobuf:"varint,4,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	FileId       string `protobuf:"bytes,5,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	GroupCode    int64  `protobuf:"varint,6,opt,name=groupCode,proto3" json:"groupCode,omitempty"`
+	AgentType    int32  `protobuf:"varint,7,opt,name=agentType,proto3" json:"agentType,omitempty"`
+	FileMd5      []byte `protobuf:"bytes,8,opt,name=fileMd5,proto3" json:"fileMd5,omitempty"`
+	BusinessType int32  `protobuf:"varint,9,opt,name=businessType,proto3" json:"businessType,omitempty"`
+	FileType     int32  `protobuf:"varint,10,opt,name=fileType,proto3" json:"fileType,omitempty"`
+	DownType     int32  `protobuf:"varint,11,opt,name=downType,proto3" json:"downType,omitempty"`
+	SceneType    int32  `protobuf:"varint,12,opt,name=sceneType,proto3" json:"sceneType,omitempty"`
+}
+
+func (x *PttShortVideoDownloadReq) Reset() {
+	*x = PttShortVideoDownloadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoDownloadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoDownloadReq) ProtoMessage() {}
+
+func (x *PttShortVideoDownloadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoDownloadReq.ProtoReflect.Descriptor instead.
+func (*PttShortVideoDownloadReq) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PttShortVideoDownloadReq) GetFromUin() int64 {
+	if x != nil {
+		return x.FromUin
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetToUin() int64 {
+	if x != nil {
+		return x.ToUin
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetChatType() int32 {
+	if x != nil {
+		return x.ChatType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetClientType() int32 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *PttShortVideoDownloadReq) GetGroupCode() int64 {
+	if x != nil {
+		return x.GroupCode
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetAgentType() int32 {
+	if x != nil {
+		return x.AgentType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetFileMd5() []byte {
+	if x != nil {
+		return x.FileMd5
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadReq) GetBusinessType() int32 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetFileType() int32 {
+	if x != nil {
+		return x.FileType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetDownType() int32 {
+	if x != nil {
+		return x.DownType
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadReq) GetSceneType() int32 {
+	if x != nil {
+		return x.SceneType
+	}
+	return 0
+}
+
+type PttShortVideoDownloadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetCode           int32                      `protobuf:"varint,1,opt,name=retCode,proto3" json:"retCode,omitempty"`
+	RetMsg            string                     `protobuf:"bytes,2,opt,name=retMsg,proto3" json:"retMsg,omitempty"`
+	SameAreaOutAddr   []*PttShortVideoIpList     `protobuf:"bytes,3,rep,name=sameAreaOutAddr,proto3" json:"sameAreaOutAddr,omitempty"`
+	DiffAreaOutAddr   []*PttShortVideoIpList     `protobuf:"bytes,4,rep,name=diffAreaOutAddr,proto3" json:"diffAreaOutAddr,omitempty"`
+	DownloadKey       []byte                     `protobuf:"bytes,5,opt,name=downloadKey,proto3" json:"downloadKey,omitempty"`
+	FileMd5           []byte                     `protobuf:"bytes,6,opt,name=fileMd5,proto3" json:"fileMd5,omitempty"`
+	SameAreaInnerAddr []*PttShortVideoIpList     `protobuf:"bytes,7,rep,name=sameAreaInnerAddr,proto3" json:"sameAreaInnerAddr,omitempty"`
+	DiffAreaInnerAddr []*PttShortVideoIpList     `protobuf:"bytes,8,rep,name=diffAreaInnerAddr,proto3" json:"diffAreaInnerAddr,omitempty"`
+	DownloadAddr      *PttShortVideoDownloadAddr `protobuf:"bytes,9,opt,name=downloadAddr,proto3" json:"downloadAddr,omitempty"`
+	EncryptKey        []byte                     `protobuf:"bytes,10,opt,name=encryptKey,proto3" json:"encryptKey,omitempty"`
+}
+
+func (x *PttShortVideoDownloadResp) Reset() {
+	*x = PttShortVideoDownloadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoDownloadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoDownloadResp) ProtoMessage() {}
+
+func (x *PttShortVideoDownloadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoDownloadResp.ProtoReflect.Descriptor instead.
+func (*PttShortVideoDownloadResp) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PttShortVideoDownloadResp) GetRetCode() int32 {
+	if x != nil {
+		return x.RetCode
+	}
+	return 0
+}
+
+func (x *PttShortVideoDownloadResp) GetRetMsg() string {
+	if x != nil {
+		return x.RetMsg
+	}
+	return ""
+}
+
+func (x *PttShortVideoDownloadResp) GetSameAreaOutAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.SameAreaOutAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetDiffAreaOutAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DiffAreaOutAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetDownloadKey() []byte {
+	if x != nil {
+		return x.DownloadKey
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetFileMd5() []byte {
+	if x != nil {
+		return x.FileMd5
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetSameAreaInnerAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.SameAreaInnerAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetDiffAreaInnerAddr() []*PttShortVideoIpList {
+	if x != nil {
+		return x.DiffAreaInnerAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetDownloadAddr() *PttShortVideoDownloadAddr {
+	if x != nil {
+		return x.DownloadAddr
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadResp) GetEncryptKey() []byte {
+	if x != nil {
+		return x.EncryptKey
+	}
+	return nil
+}
+
+type PttShortVideoIpList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip   int32 `protobuf:"varint,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *PttShortVideoIpList) Reset() {
+	*x = PttShortVideoIpList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoIpList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoIpList) ProtoMessage() {}
+
+func (x *PttShortVideoIpList) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoIpList.ProtoReflect.Descriptor instead.
+func (*PttShortVideoIpList) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PttShortVideoIpList) GetIp() int32 {
+	if x != nil {
+		return x.Ip
+	}
+	return 0
+}
+
+func (x *PttShortVideoIpList) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type PttShortVideoDownloadAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    []string `protobuf:"bytes,1,rep,name=host,proto3" json:"host,omitempty"`
+	UrlArgs string   `protobuf:"bytes,2,opt,name=urlArgs,proto3" json:"urlArgs,omitempty"`
+}
+
+func (x *PttShortVideoDownloadAddr) Reset() {
+	*x = PttShortVideoDownloadAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shortvideo_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttShortVideoDownloadAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttShortVideoDownloadAddr) ProtoMessage() {}
+
+func (x *PttShortVideoDownloadAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_shortvideo_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttShortVideoDownloadAddr.ProtoReflect.Descriptor instead.
+func (*PttShortVideoDownloadAddr) Descriptor() ([]byte, []int) {
+	return file_shortvideo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PttShortVideoDownloadAddr) GetHost() []string {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *PttShortVideoDownloadAddr) GetUrlArgs() string {
+	if x != nil {
+		return x.UrlArgs
+	}
+	return ""
+}
+
+var File_shortvideo_proto protoreflect.FileDescriptor
+
+var file_shortvideo_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a, 0x11, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x4f, 0x0a, 0x16,
+	0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x50,
+	0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x52, 0x16, 0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x55, 0x0a,
+	0x18, 0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x52, 0x18, 0x70, 0x74, 0x74, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x22, 0xe1, 0x01, 0x0a, 0x11, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x73, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x50,
+	0x0a, 0x16, 0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x16, 0x70, 0x74, 0x74, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70,
+	0x12, 0x56, 0x0a, 0x18, 0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x52, 0x18,
+	0x70, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x73, 0x70, 0x22, 0xc4, 0x02, 0x0a, 0x16, 0x50, 0x74, 0x74,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x75, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x75, 0x69, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x75, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x75, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x62,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x66,
+	0x6c, 0x61, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x66, 0x6c, 0x61, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0xb9, 0x02, 0x0a, 0x15, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x12,
+	0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x46, 0x69, 0x6c, 0x65,
+	0x4d, 0x64, 0x35, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66,
+	0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf3, 0x04, 0x0a, 0x17,
+	0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x3e, 0x0a, 0x0f, 0x73, 0x61, 0x6d,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x69, 0x66,
+	0x66, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72,
+	0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x75, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x49,
+	0x6e, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x11, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x49, 0x6e,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x42, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x41,
+	0x72, 0x65, 0x61, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x11, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72,
+	0x65, 0x61, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x6f, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x48, 0x6f, 0x6c,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x48, 0x6f, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x73, 0x48, 0x6f, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x1c, 0x6c, 0x6f, 0x6e, 0x67, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x43, 0x61, 0x72, 0x72, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1c, 0x6c, 0x6f, 0x6e, 0x67, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x43, 0x61, 0x72, 0x72, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x41,
+	0x72, 0x65, 0x61, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x41, 0x72, 0x65, 0x61, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0xee, 0x02, 0x0a, 0x18, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x55, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x55, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xf1, 0x03, 0x0a, 0x19, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x3e, 0x0a, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74,
+	0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74,
+	0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72, 0x65, 0x61, 0x4f, 0x75, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x64, 0x35, 0x12, 0x42,
+	0x0a, 0x11, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x74, 0x74, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x11, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x72, 0x65, 0x61, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x42, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72, 0x65, 0x61, 0x49, 0x6e,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x11, 0x64, 0x69, 0x66, 0x66, 0x41, 0x72, 0x65, 0x61, 0x49, 0x6e, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x50,
+	0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x4b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x13, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x49, 0x0a, 0x19, 0x50, 0x74, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x42, 0x0d, 0x5a, 0x0b,
+	0x2e, 0x3b, 0x70, 0x74, 0x74, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shortvideo_proto_rawDescOnce sync.Once
+	file_shortvideo_proto_rawDescData = file_shortvideo_proto_rawDesc
+)
+
+func file_shortvideo_proto_rawDescGZIP() []byte {
+	file_shortvideo_proto_rawDescOnce.Do(func() {
+		file_shortvideo_proto_rawDescData = protoimpl.X.CompressGZIP(file_shortvideo_proto_rawDescData)
+	})
+	return file_shortvideo_proto_rawDescData
+}
+
+var file_shortvideo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_shortvideo_proto_goTypes = []interface{}{
+	(*ShortVideoReqBody)(nil),         // 0: ShortVideoReqBody
+	(*ShortVideoRspBody)(nil),         // 1: ShortVideoRspBody
+	(*PttShortVideoUploadReq)(nil),    // 2: PttShortVideoUploadReq
+	(*PttShortVideoFileInfo)(nil),     // 3: PttShortVideoFileInfo
+	(*PttShortVideoUploadResp)(nil),   // 4: PttShortVideoUploadResp
+	(*PttShortVideoDownloadReq)(nil),  // 5: PttShortVideoDownloadReq
+	(*PttShortVideoDownloadResp)(nil), // 6: PttShortVideoDownloadResp
+	(*PttShortVideoIpList)(nil),       // 7: PttShortVideoIpList
+	(*PttShortVideoDownloadAddr)(nil), // 8: PttShortVideoDownloadAddr
+}
+var file_shortvideo_proto_depIdxs = []int32{
+	2,  // 0: ShortVideoReqBody.pttShortVideoUploadReq:type_name -> PttShortVideoUploadReq
+	5,  // 1: ShortVideoReqBody.pttShortVideoDownloadReq:type_name -> PttShortVideoDownloadReq
+	4,  // 2: ShortVideoRspBody.pttShortVideoUploadRsp:type_name -> PttShortVideoUploadResp
+	6,  // 3: ShortVideoRspBody.pttShortVideoDownloadRsp:type_name -> PttShortVideoDownloadResp
+	3,  // 4: PttShortVideoUploadReq.info:type_name -> PttShortVideoFileInfo
+	7,  // 5: PttShortVideoUploadResp.sameAreaOutAddr:type_name -> PttShortVideoIpList
+	7,  // 6: PttShortVideoUploadResp.diffAreaOutAddr:type_name -> PttShortVideoIpList
+	7,  // 7: PttShortVideoUploadResp.sameAreaInnerAddr:type_name -> PttShortVideoIpList
+	7,  // 8: PttShortVideoUploadResp.diffAreaInnerAddr:type_name -> PttShortVideoIpList
+	7,  // 9: PttShortVideoUploadResp.dataHoleAddr:type_name -> PttShortVideoIpList
+	7,  // 10: PttShortVideoUploadResp.dataAreaAddr:type_name -> PttShortVideoIpList
+	7,  // 11: PttShortVideoDownloadResp.sameAreaOutAddr:type_name -> PttShortVideoIpList
+	7,  // 12: PttShortVideoDownloadResp.diffAreaOutAddr:type_name -> PttShortVideoIpList
+	7,  // 13: PttShortVideoDownloadResp.sameAreaInnerAddr:type_name -> PttShortVideoIpList
+	7,  // 14: PttShortVideoDownloadResp.diffAreaInnerAddr:type_name -> PttShortVideoIpList
+	8,  // 15: PttShortVideoDownloadResp.downloadAddr:type_name -> PttShortVideoDownloadAddr
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_shortvideo_proto_init() }
+func file_shortvideo_proto_init() {
+	if File_shortvideo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_shortvideo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortVideoReqBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortVideoRspBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoUploadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoFileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoUploadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoDownloadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoDownloadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoIpList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shortvideo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PttShortVideoDownloadAddr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shortvideo_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_shortvideo_proto_goTypes,
+		DependencyIndexes: file_shortvideo_proto_depIdxs,
+		MessageInfos:      file_shortvideo_proto_msgTypes,
+	}.Build()
+	File_shortvideo_proto = out.File
+	file_shortvideo_proto_rawDesc = nil
+	file_shortvideo_proto_goTypes = nil
+	file_shortvideo_proto_depIdxs = nil
+}
@@ -2,69 +2,171 @@ package message
 
 import (
 	"fmt"
+	"math/rand"
 	"strconv"
 	"strings"
 )
 
 type TextElement struct {
-	Content string
+	Content string `json:"Content"`
 }
 
 type ImageElement struct {
-	Filename string
-	Size     int32
-	Url      string
-	Md5      []byte
-	Data     []byte
+	Filename     string       `json:"Filename"`
+	Size         int32        `json:"Size"`
+	Url          string       `json:"Url"`
+	Md5          []byte       `json:"Md5"`
+	Data         []byte       `json:"Data"`
+	ImageBizType ImageBizType `json:"ImageBizType"`
+	EffectId     int32        `json:"EffectId"`
 }
 
 type GroupImageElement struct {
-	ImageId string
-	Md5     []byte
-	Url     string
+	ImageId      string       `json:"ImageId"`
+	Md5          []byte       `json:"Md5"`
+	Url          string       `json:"Url"`
+	ImageBizType ImageBizType `json:"ImageBizType"`
 }
 
 type FriendImageElement struct {
-	ImageId string
-	Md5     []byte
-	Url     string
+	ImageId      string       `json:"ImageId"`
+	Md5          []byte       `json:"Md5"`
+	Url          string       `json:"Url"`
+	ImageBizType ImageBizType `json:"ImageBizType"`
+}
+
+type FlashImageElement struct {
+	ImageElement
+}
+
+type GroupFlashImgElement struct {
+	GroupImageElement
+}
+
+type FriendFlashImgElement struct {
+	FriendImageElement
+}
+
+type GroupShowPicElement struct {
+	GroupImageElement
+	EffectId int32 `json:"EffectId"`
 }
 
 type FaceElement struct {
-	Index int32
-	Name  string
+	Index int32  `json:"Index"`
+	Name  string `json:"Name"`
 }
 
 type AtElement struct {
-	Target  int64
-	Display string
+	Target  int64  `json:"Target"`
+	Display string `json:"Display"`
 }
 
 type GroupFileElement struct {
-	Name  string
-	Size  int64
-	Path  string
-	Busid int32
+	Name  string `json:"Name"`
+	Size  int64  `json:"Size"`
+	Path  string `json:"Path"`
+	Busid int32  `json:"Busid"`
+}
+
+// FriendFileElement 好友离线文件, 下载链接需通过 QQClient.GetFriendFileUrl 获取
+type FriendFileElement struct {
+	Name       string `json:"Name"`
+	Size       int64  `json:"Size"`
+	Uuid       []byte `json:"Uuid"`
+	Md5        []byte `json:"Md5"`
+	ExpireTime int32  `json:"ExpireTime"`
 }
 
 type ReplyElement struct {
-	ReplySeq int32
-	Sender   int64
-	Time     int32
-	Elements []IMessageElement
+	ReplySeq   int32             `json:"ReplySeq"`
+	InternalId int32             `json:"InternalId"`
+	GroupId    int64             `json:"GroupId"` // 私聊及临时会话为 0
+	Sender     int64             `json:"Sender"`
+	Time       int32             `json:"Time"`
+	Elements   []IMessageElement `json:"Elements"`
 
 	//original []*msg.Elem
 }
 
 type ServiceElement struct {
-	Id      int32
-	Content string
-	ResId   string
-	SubType string
+	Id      int32  `json:"Id"`
+	Content string `json:"Content"`
+	ResId   string `json:"ResId"`
+	SubType string `json:"SubType"`
 }
 
 type ForwardElement struct {
-	ResId string
+	ResId    string `json:"ResId"`
+	FileName string `json:"FileName"`
+}
+
+type PokeElement struct {
+	Name     string `json:"Name"`
+	PokeType int32  `json:"PokeType"`
+	Id       int32  `json:"Id"`
+}
+
+type MarketFaceElement struct {
+	Name       string `json:"Name"`
+	FaceId     []byte `json:"FaceId"`
+	TabId      int32  `json:"TabId"`
+	ItemType   int32  `json:"ItemType"`
+	SubType    int32  `json:"SubType"`
+	MediaType  int32  `json:"MediaType"`
+	EncryptKey []byte `json:"EncryptKey"`
+	MagicValue string `json:"MagicValue"`
+}
+
+type DiceElement struct {
+	MarketFaceElement
+	Value int32 `json:"Value"`
+}
+
+type FingerGuessingElement struct {
+	MarketFaceElement
+	Value int32 `json:"Value"`
+}
+
+type AnonymousElement struct {
+	AnonymousInfo
+}
+
+type LightAppElement struct {
+	Content string `json:"Content"`
+}
+
+type XmlElement struct {
+	ServiceId int32  `json:"ServiceId"`
+	Content   string `json:"Content"`
+}
+
+type LocationElement struct {
+	Name      string  `json:"Name"`
+	Address   string  `json:"Address"`
+	Latitude  float64 `json:"Latitude"`
+	Longitude float64 `json:"Longitude"`
+}
+
+type MusicShareElement struct {
+	MusicType  int    `json:"MusicType"`
+	Title      string `json:"Title"`
+	Summary    string `json:"Summary"`
+	Url        string `json:"Url"`
+	PictureUrl string `json:"PictureUrl"`
+	MusicUrl   string `json:"MusicUrl"`
+}
+
+type ShortVideoElement struct {
+	Name      string `json:"Name"`
+	Uuid      []byte `json:"Uuid"`
+	Size      int32  `json:"Size"`
+	ThumbSize int32  `json:"ThumbSize"`
+	Md5       []byte `json:"Md5"`
+	ThumbMd5  []byte `json:"ThumbMd5"`
+	Duration  int32  `json:"Duration"`
+	Width     int32  `json:"Width"`
+	Height    int32  `json:"Height"`
 }
 
 func NewText(s string) *TextElement {
@@ -85,6 +187,21 @@ func NewGroupImage(id string, md5 []byte) *GroupImageElement {
 	}
 }
 
+func NewGroupFlashImage(img *GroupImageElement) *GroupFlashImgElement {
+	return &GroupFlashImgElement{GroupImageElement: *img}
+}
+
+func NewFriendFlashImage(img *FriendImageElement) *FriendFlashImgElement {
+	return &FriendFlashImgElement{FriendImageElement: *img}
+}
+
+func NewGroupShowPic(img *GroupImageElement, effectId int32) *GroupShowPicElement {
+	return &GroupShowPicElement{
+		GroupImageElement: *img,
+		EffectId:          effectId,
+	}
+}
+
 func NewFace(index int32) *FaceElement {
 	name := faceMap[int(index)]
 	if name == "" {
@@ -116,14 +233,36 @@ func AtAll() *AtElement {
 
 func NewReply(m *GroupMessage) *ReplyElement {
 	return &ReplyElement{
-		ReplySeq: m.Id,
-		Sender:   m.Sender.Uin,
-		Time:     m.Time,
+		ReplySeq:   m.Id,
+		InternalId: m.InternalId,
+		GroupId:    m.GroupCode,
+		Sender:     m.Sender.Uin,
+		Time:       m.Time,
 		//original: m.OriginalElements,
 		Elements: m.Elements,
 	}
 }
 
+func NewPrivateReply(m *PrivateMessage) *ReplyElement {
+	return &ReplyElement{
+		ReplySeq:   m.Id,
+		InternalId: m.InternalId,
+		Sender:     m.Sender.Uin,
+		Time:       m.Time,
+		Elements:   m.Elements,
+	}
+}
+
+func NewTempReply(m *TempMessage) *ReplyElement {
+	return &ReplyElement{
+		ReplySeq:   m.Id,
+		InternalId: m.InternalId,
+		Sender:     m.Sender.Uin,
+		Time:       m.Time,
+		Elements:   m.Elements,
+	}
+}
+
 func NewUrlShare(url, title, content, image string) *ServiceElement {
 	template := fmt.Sprintf(`<?xml version='1.0' encoding='UTF-8' standalone='yes'?><msg templateID="123" url="%s" serviceID="33" action="web" actionData="" brief="【链接】%s" flag="8"><item layout="2"><picture cover="%s"/><title>%s</title><summary>%s</summary></item></msg>`,
 		url, url, image, title, content,
@@ -136,6 +275,102 @@ func NewUrlShare(url, title, content, image string) *ServiceElement {
 	}
 }
 
+// NewPoke 戳一戳消息, 默认为 NewPoke("戳一戳", 1, -1)
+func NewPoke(name string, pokeType, id int32) *PokeElement {
+	return &PokeElement{
+		Name:     name,
+		PokeType: pokeType,
+		Id:       id,
+	}
+}
+
+// NewDice 骰子, value 取值 1-6
+func NewDice(value int32) *DiceElement {
+	if value < 1 || value > 6 {
+		value = rand.Int31n(6) + 1
+	}
+	return &DiceElement{
+		MarketFaceElement: MarketFaceElement{
+			Name:       "[骰子]",
+			FaceId:     []byte{0x48, 0x23, 0xd3, 0xad, 0xb1, 0x5d, 0xf0, 0x80, 0x14, 0xce, 0x5d, 0x7c, 0x6b, 0xe2, 0xc1, 0x87},
+			TabId:      diceTabId,
+			ItemType:   6,
+			SubType:    3,
+			MediaType:  0,
+			EncryptKey: []byte("409e2a69b16918f9"),
+			MagicValue: "rscType?1;value=" + strconv.FormatInt(int64(value-1), 10),
+		},
+		Value: value,
+	}
+}
+
+// NewFingerGuessing 猜拳, value 取值 0: 石头 1: 剪刀 2: 布
+func NewFingerGuessing(value int32) *FingerGuessingElement {
+	if value < 0 || value > 2 {
+		value = rand.Int31n(3)
+	}
+	return &FingerGuessingElement{
+		MarketFaceElement: MarketFaceElement{
+			Name:       "[猜拳]",
+			FaceId:     []byte{0x83, 0xc8, 0xa2, 0x93, 0xae, 0x65, 0xca, 0x14, 0x0f, 0x34, 0x81, 0x20, 0xa7, 0x74, 0x48, 0xee},
+			TabId:      fingerGuessingTabId,
+			ItemType:   6,
+			SubType:    3,
+			MediaType:  0,
+			EncryptKey: []byte("7de39febcf45e6db"),
+			MagicValue: "rscType?1;value=" + strconv.FormatInt(int64(value), 10),
+		},
+		Value: value,
+	}
+}
+
+func (e *FingerGuessingElement) ValueName() string {
+	switch e.Value {
+	case 0:
+		return "石头"
+	case 1:
+		return "剪刀"
+	case 2:
+		return "布"
+	}
+	return "未知"
+}
+
+func NewAnonymous(info *AnonymousInfo) *AnonymousElement {
+	return &AnonymousElement{AnonymousInfo: *info}
+}
+
+func NewLightApp(content string) *LightAppElement {
+	return &LightAppElement{Content: content}
+}
+
+func NewXml(serviceId int32, content string) *XmlElement {
+	return &XmlElement{
+		ServiceId: serviceId,
+		Content:   content,
+	}
+}
+
+func NewLocation(lat, lon float64, name, address string) *LocationElement {
+	return &LocationElement{
+		Name:      name,
+		Address:   address,
+		Latitude:  lat,
+		Longitude: lon,
+	}
+}
+
+func NewMusicShare(musicType int, title, summary, url, pictureUrl, musicUrl string) *MusicShareElement {
+	return &MusicShareElement{
+		MusicType:  musicType,
+		Title:      title,
+		Summary:    summary,
+		Url:        url,
+		PictureUrl: pictureUrl,
+		MusicUrl:   musicUrl,
+	}
+}
+
 func (e *TextElement) Type() ElementType {
 	return Text
 }
@@ -156,6 +391,38 @@ func (e *FriendImageElement) Type() ElementType {
 	return Image
 }
 
+func (e *PokeElement) Type() ElementType {
+	return Poke
+}
+
+func (e *MarketFaceElement) Type() ElementType {
+	return MarketFace
+}
+
+func (e *DiceElement) Type() ElementType {
+	return MarketFace
+}
+
+func (e *FingerGuessingElement) Type() ElementType {
+	return MarketFace
+}
+
+func (e *FlashImageElement) Type() ElementType {
+	return Image
+}
+
+func (e *GroupFlashImgElement) Type() ElementType {
+	return Image
+}
+
+func (e *FriendFlashImgElement) Type() ElementType {
+	return Image
+}
+
+func (e *GroupShowPicElement) Type() ElementType {
+	return Image
+}
+
 func (e *AtElement) Type() ElementType {
 	return At
 }
@@ -176,6 +443,70 @@ func (e *GroupFileElement) Type() ElementType {
 	return File
 }
 
+func (e *FriendFileElement) Type() ElementType {
+	return File
+}
+
+func (e *ShortVideoElement) Type() ElementType {
+	return Video
+}
+
+func (e *AnonymousElement) Type() ElementType {
+	return Anonymous
+}
+
+func (e *LightAppElement) Type() ElementType {
+	return LightApp
+}
+
+func (e *XmlElement) Type() ElementType {
+	return Xml
+}
+
+func (e *LocationElement) Type() ElementType {
+	return Location
+}
+
+func (e *MusicShareElement) Type() ElementType {
+	return Music
+}
+
+const (
+	QQMusic = iota
+	CloudMusic
+	CustomMusic
+)
+
+const (
+	diceTabId           int32 = 11464
+	fingerGuessingTabId int32 = 11415
+)
+
+type ImageBizType uint32
+
+const (
+	UnknownBizType  ImageBizType = 0
+	CustomFaceImage ImageBizType = 1
+	HotImage        ImageBizType = 2
+	DouImage        ImageBizType = 3 // 斗图
+	ZhiTuImage      ImageBizType = 4
+	StickerImage    ImageBizType = 7
+	SelfieImage     ImageBizType = 8
+	StickerAdImage  ImageBizType = 9
+	RelatedEmoImage ImageBizType = 10
+	HotSearchImage  ImageBizType = 13
+)
+
+// 秀图特效
+const (
+	ShowPicNormal int32 = 40000 + iota
+	ShowPicPhantom
+	ShowPicShake
+	ShowPicBirthday
+	ShowPicLove
+	ShowPicFriends
+)
+
 var faceMap = map[int]string{
 	14:  "微笑",
 	1:   "撇嘴",
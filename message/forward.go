@@ -0,0 +1,60 @@
+package message
+
+import (
+	"fmt"
+)
+
+func NewForwardMessage() *ForwardMessage {
+	return &ForwardMessage{}
+}
+
+func NewForwardNode(senderId int64, senderName string, time int32, elems ...IMessageElement) *ForwardNode {
+	return &ForwardNode{
+		SenderId:   senderId,
+		SenderName: senderName,
+		Time:       time,
+		Message:    elems,
+	}
+}
+
+func (forMsg *ForwardMessage) AddNode(node *ForwardNode) *ForwardMessage {
+	forMsg.Nodes = append(forMsg.Nodes, node)
+	return forMsg
+}
+
+func (forMsg *ForwardMessage) AddGroupMessage(m *GroupMessage) *ForwardMessage {
+	return forMsg.AddNode(NewForwardNode(m.Sender.Uin, m.Sender.DisplayName(), m.Time, m.Elements...))
+}
+
+func (forMsg *ForwardMessage) AddPrivateMessage(m *PrivateMessage) *ForwardMessage {
+	return forMsg.AddNode(NewForwardNode(m.Sender.Uin, m.Sender.DisplayName(), m.Time, m.Elements...))
+}
+
+func (forMsg *ForwardMessage) AddTempMessage(m *TempMessage) *ForwardMessage {
+	return forMsg.AddNode(NewForwardNode(m.Sender.Uin, m.Sender.DisplayName(), m.Time, m.Elements...))
+}
+
+// AddForward 添加一条嵌套的合并转发
+func (forMsg *ForwardMessage) AddForward(senderId int64, senderName string, time int32, nested *ForwardMessage) *ForwardMessage {
+	return forMsg.AddNode(NewForwardNode(senderId, senderName, time, nested))
+}
+
+// Type 使 ForwardMessage 可以作为 ForwardNode 中的嵌套转发
+func (forMsg *ForwardMessage) Type() ElementType {
+	return Forward
+}
+
+func (forMsg *ForwardMessage) nestedTemplate(fileName string) *ServiceElement {
+	var pv string
+	for i := 0; i < len(forMsg.Nodes) && i < 4; i++ {
+		pv += fmt.Sprintf(`<title size="26" color="#777777">%s: %s</title>`, escapeXml(forMsg.Nodes[i].SenderName), escapeXml(ToReadableString(forMsg.Nodes[i].Message)))
+	}
+	template := fmt.Sprintf(`<?xml version='1.0' encoding='UTF-8'?><msg serviceID="35" templateID="1" action="viewMultiMsg" brief="[聊天记录]" m_resid="" m_fileName="%s" tSum="%d" sourceMsgId="0" url="" flag="3" adverSign="0" multiMsgFlag="0"><item layout="1"><title color="#000000" size="34">聊天记录</title> %s<hr></hr><summary size="26" color="#808080">查看 %d 条转发消息</summary></item><source name="聊天记录"></source></msg>`,
+		fileName, len(forMsg.Nodes), pv, len(forMsg.Nodes),
+	)
+	return &ServiceElement{
+		Id:      35,
+		Content: template,
+		SubType: "Forward",
+	}
+}
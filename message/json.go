@@ -0,0 +1,270 @@
+package message
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// JsonVersion 当前消息 JSON 编码的版本.
+// 元素字段通过 json tag 固定键名, 重命名字段时不要修改 tag, 否则需要提升版本
+const JsonVersion = 1
+
+type jsonMessage struct {
+	Version  int            `json:"version"`
+	Elements []*jsonElement `json:"elements"`
+}
+
+type jsonElement struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+var (
+	elementTypes = map[string]reflect.Type{}
+	elementNames = map[reflect.Type]string{}
+)
+
+func init() {
+	RegisterElementType("text", &TextElement{})
+	RegisterElementType("image", &ImageElement{})
+	RegisterElementType("group_image", &GroupImageElement{})
+	RegisterElementType("friend_image", &FriendImageElement{})
+	RegisterElementType("flash_image", &FlashImageElement{})
+	RegisterElementType("group_flash_image", &GroupFlashImgElement{})
+	RegisterElementType("friend_flash_image", &FriendFlashImgElement{})
+	RegisterElementType("group_show_pic", &GroupShowPicElement{})
+	RegisterElementType("face", &FaceElement{})
+	RegisterElementType("at", &AtElement{})
+	RegisterElementType("group_file", &GroupFileElement{})
+	RegisterElementType("friend_file", &FriendFileElement{})
+	RegisterElementType("reply", &ReplyElement{})
+	RegisterElementType("service", &ServiceElement{})
+	RegisterElementType("forward", &ForwardElement{})
+	RegisterElementType("forward_message", &ForwardMessage{})
+	RegisterElementType("anonymous", &AnonymousElement{})
+	RegisterElementType("light_app", &LightAppElement{})
+	RegisterElementType("xml", &XmlElement{})
+	RegisterElementType("location", &LocationElement{})
+	RegisterElementType("music_share", &MusicShareElement{})
+	RegisterElementType("short_video", &ShortVideoElement{})
+	RegisterElementType("poke", &PokeElement{})
+	RegisterElementType("market_face", &MarketFaceElement{})
+	RegisterElementType("dice", &DiceElement{})
+	RegisterElementType("finger_guessing", &FingerGuessingElement{})
+}
+
+// RegisterElementType 注册元素在 JSON 中的类型名, e 必须为结构体指针
+func RegisterElementType(name string, e IMessageElement) {
+	t := reflect.TypeOf(e)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic("message: element must be a pointer to struct")
+	}
+	elementTypes[name] = t.Elem()
+	elementNames[t] = name
+}
+
+// ToJson 将消息元素无损编码为 JSON
+func ToJson(elems []IMessageElement) ([]byte, error) {
+	encoded, err := encodeElements(elems)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&jsonMessage{
+		Version:  JsonVersion,
+		Elements: encoded,
+	})
+}
+
+// ParseJson 从 ToJson 的结果还原消息元素
+func ParseJson(data []byte) ([]IMessageElement, error) {
+	m := jsonMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if m.Version <= 0 || m.Version > JsonVersion {
+		return nil, fmt.Errorf("unsupported message json version %d", m.Version)
+	}
+	return decodeElements(m.Elements)
+}
+
+func encodeElements(elems []IMessageElement) ([]*jsonElement, error) {
+	r := make([]*jsonElement, 0, len(elems))
+	for _, e := range elems {
+		name, ok := elementNames[reflect.TypeOf(e)]
+		if !ok {
+			return nil, fmt.Errorf("unregistered element type %T", e)
+		}
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, &jsonElement{Type: name, Data: b})
+	}
+	return r, nil
+}
+
+func decodeElements(elems []*jsonElement) ([]IMessageElement, error) {
+	r := make([]IMessageElement, 0, len(elems))
+	for _, je := range elems {
+		if je == nil {
+			return nil, errors.New("null element")
+		}
+		t, ok := elementTypes[je.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown element type %q", je.Type)
+		}
+		v := reflect.New(t)
+		if err := json.Unmarshal(je.Data, v.Interface()); err != nil {
+			return nil, err
+		}
+		r = append(r, v.Interface().(IMessageElement))
+	}
+	return r, nil
+}
+
+func (e *ReplyElement) MarshalJSON() ([]byte, error) {
+	type alias ReplyElement
+	elems, err := encodeElements(e.Elements)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(e), Elements: elems})
+}
+
+func (e *ReplyElement) UnmarshalJSON(data []byte) error {
+	type alias ReplyElement
+	v := &struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	elems, err := decodeElements(v.Elements)
+	if err != nil {
+		return err
+	}
+	e.Elements = elems
+	return nil
+}
+
+func (node *ForwardNode) MarshalJSON() ([]byte, error) {
+	type alias ForwardNode
+	elems, err := encodeElements(node.Message)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*alias
+		Message []*jsonElement `json:"Message"`
+	}{alias: (*alias)(node), Message: elems})
+}
+
+func (node *ForwardNode) UnmarshalJSON(data []byte) error {
+	type alias ForwardNode
+	v := &struct {
+		*alias
+		Message []*jsonElement `json:"Message"`
+	}{alias: (*alias)(node)}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	elems, err := decodeElements(v.Message)
+	if err != nil {
+		return err
+	}
+	node.Message = elems
+	return nil
+}
+
+func (msg *GroupMessage) MarshalJSON() ([]byte, error) {
+	type alias GroupMessage
+	elems, err := encodeElements(msg.Elements)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg), Elements: elems})
+}
+
+func (msg *GroupMessage) UnmarshalJSON(data []byte) error {
+	type alias GroupMessage
+	v := &struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg)}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	elems, err := decodeElements(v.Elements)
+	if err != nil {
+		return err
+	}
+	msg.Elements = elems
+	return nil
+}
+
+func (msg *PrivateMessage) MarshalJSON() ([]byte, error) {
+	type alias PrivateMessage
+	elems, err := encodeElements(msg.Elements)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg), Elements: elems})
+}
+
+func (msg *PrivateMessage) UnmarshalJSON(data []byte) error {
+	type alias PrivateMessage
+	v := &struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg)}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	elems, err := decodeElements(v.Elements)
+	if err != nil {
+		return err
+	}
+	msg.Elements = elems
+	return nil
+}
+
+func (msg *TempMessage) MarshalJSON() ([]byte, error) {
+	type alias TempMessage
+	elems, err := encodeElements(msg.Elements)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg), Elements: elems})
+}
+
+func (msg *TempMessage) UnmarshalJSON(data []byte) error {
+	type alias TempMessage
+	v := &struct {
+		*alias
+		Elements []*jsonElement `json:"Elements"`
+	}{alias: (*alias)(msg)}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	elems, err := decodeElements(v.Elements)
+	if err != nil {
+		return err
+	}
+	msg.Elements = elems
+	return nil
+}
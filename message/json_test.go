@@ -0,0 +1,83 @@
+package message
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJsonRoundTrip(t *testing.T) {
+	md5 := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	elems := []IMessageElement{
+		NewText("文本\"[1]\""),
+		&ImageElement{Filename: "{ABC}.png", Size: 1024, Url: "http://gchat.qpic.cn/a?b=1&c=2", Md5: md5, Data: []byte{1, 2}, ImageBizType: StickerImage, EffectId: ShowPicShake},
+		&GroupImageElement{ImageId: "{ABC}.png", Md5: md5, Url: "http://x/y", ImageBizType: SelfieImage},
+		&FriendImageElement{ImageId: "/1234-abcd", Md5: md5, Url: "http://c2c/x"},
+		&FlashImageElement{ImageElement: ImageElement{Filename: "f.jpg", Md5: md5}},
+		NewGroupFlashImage(&GroupImageElement{ImageId: "{F}.jpg", Md5: md5}),
+		NewFriendFlashImage(&FriendImageElement{ImageId: "/f", Md5: md5}),
+		NewGroupShowPic(&GroupImageElement{ImageId: "{S}.jpg", Md5: md5}, ShowPicLove),
+		NewFace(14),
+		NewAt(123456, "@名字"),
+		&GroupFileElement{Name: "a.txt", Size: 2048, Path: "/abc", Busid: 102},
+		&FriendFileElement{Name: "c.pdf", Size: 4096, Uuid: []byte("/uuid-1"), Md5: md5, ExpireTime: 1600604800},
+		&ReplyElement{ReplySeq: 1000, InternalId: -12345, GroupId: 111, Sender: 222, Time: 1600000000, Elements: []IMessageElement{
+			NewText("原消息"), NewAt(333), NewFace(1),
+		}},
+		NewUrlShare("https://example.com/?a=1", "标题", "内容", "https://example.com/a.png"),
+		&ForwardElement{ResId: "abc/def", FileName: "1600000000"},
+		NewForwardMessage().AddNode(NewForwardNode(1, "a", 1600000000, NewText("节点"), NewFace(2))),
+		NewAnonymous(&AnonymousInfo{AnonymousId: "AQID", AnonymousNick: "匿名", HeadPortrait: 3, ExpireTime: 1600000000, BubbleId: 2, RankColor: "#fff", Flag: "AQID|匿名"}),
+		NewLightApp(`{"app":"com.tencent.map"}`),
+		NewXml(1, `<?xml version='1.0'?><msg serviceID="1"></msg>`),
+		NewLocation(39.908823, 116.39747, "天安门", "北京市东城区"),
+		NewMusicShare(CloudMusic, "歌名", "歌手", "https://music.163.com/song?id=1", "https://p.png", "https://m.mp3"),
+		&ShortVideoElement{Name: "v.mp4", Uuid: []byte("uuid"), Size: 100, ThumbSize: 10, Md5: md5, ThumbMd5: md5, Duration: 15, Width: 720, Height: 1280},
+		NewPoke("戳一戳", 1, -1),
+		&MarketFaceElement{Name: "[表情]", FaceId: md5, TabId: 1, ItemType: 6, SubType: 3, MediaType: 0, EncryptKey: []byte("key"), MagicValue: "rscType?1;value=1"},
+		NewDice(5),
+		NewFingerGuessing(2),
+	}
+	covered := map[reflect.Type]bool{}
+	for _, e := range elems {
+		covered[reflect.TypeOf(e)] = true
+	}
+	for name, typ := range elementTypes {
+		if !covered[reflect.PtrTo(typ)] {
+			t.Errorf("registered element type %q is not covered", name)
+		}
+	}
+	b, err := ToJson(elems)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseJson(b)
+	if err != nil {
+		t.Fatalf("parse %s: %v", b, err)
+	}
+	if !reflect.DeepEqual(elems, got) {
+		t.Fatalf("round trip mismatch\nwant: %#v\ngot:  %#v", elems, got)
+	}
+}
+
+// TestJsonStableKeys 已存储的数据依赖这些键名, 字段重命名时本测试应保持通过
+func TestJsonStableKeys(t *testing.T) {
+	b, err := ToJson([]IMessageElement{NewText("a"), &GroupImageElement{ImageId: "{A}.png", Md5: []byte{1}}, &ReplyElement{ReplySeq: 1, Elements: []IMessageElement{NewAt(2, "@b")}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":1,"elements":[` +
+		`{"type":"text","data":{"Content":"a"}},` +
+		`{"type":"group_image","data":{"ImageId":"{A}.png","Md5":"AQ==","Url":"","ImageBizType":0}},` +
+		`{"type":"reply","data":{"ReplySeq":1,"InternalId":0,"GroupId":0,"Sender":0,"Time":0,"Elements":[{"type":"at","data":{"Target":2,"Display":"@b"}}]}}]}`
+	if string(b) != want {
+		t.Fatalf("unexpected json\nwant: %s\ngot:  %s", want, b)
+	}
+}
+
+func TestJsonInvalid(t *testing.T) {
+	for _, s := range []string{`{"version":99,"elements":[]}`, `{"version":1,"elements":[{"type":"unknown","data":{}}]}`, `{"version":1,"elements":[null]}`} {
+		if _, err := ParseJson([]byte(s)); err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+	}
+}
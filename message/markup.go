@@ -0,0 +1,517 @@
+package message
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 文本标记格式:
+//   普通文本原样书写, 其中的 & [ ] 需转义为 &amp; &#91; &#93;
+//   元素写作 [类型:键=值,键=值], 值中的 , 还需转义为 &#44;
+//   每种类型的首选键可以省略键名, 如 [at:123] [face:14] [reply:1000]
+
+var markupDefaultKeys = map[string]string{
+	"at":       "qq",
+	"face":     "id",
+	"image":    "file",
+	"reply":    "id",
+	"service":  "id",
+	"forward":  "resid",
+	"file":     "name",
+	"video":    "uuid",
+	"json":     "data",
+	"xml":      "data",
+	"poke":     "name",
+	"dice":     "value",
+	"rps":      "value",
+	"location": "name",
+	"music":    "title",
+}
+
+type markupArgs map[string]string
+
+var markupDecoders map[string]func(markupArgs) (IMessageElement, error)
+
+func init() {
+	markupDecoders = map[string]func(markupArgs) (IMessageElement, error){
+		"at":        decodeAtMarkup,
+		"face":      decodeFaceMarkup,
+		"image":     decodeImageMarkup,
+		"reply":     decodeReplyMarkup,
+		"service":   decodeServiceMarkup,
+		"forward":   decodeForwardMarkup,
+		"file":      decodeFileMarkup,
+		"video":     decodeVideoMarkup,
+		"location":  decodeLocationMarkup,
+		"music":     decodeMusicMarkup,
+		"json":      decodeJsonMarkup,
+		"xml":       decodeXmlMarkup,
+		"anonymous": decodeAnonymousMarkup,
+		"poke":      decodePokeMarkup,
+		"mface":     decodeMarketFaceMarkup,
+		"dice":      decodeDiceMarkup,
+		"rps":       decodeFingerGuessingMarkup,
+	}
+}
+
+// EscapeMarkup 转义文本中的特殊字符, inParam 为 true 时按参数值转义
+func EscapeMarkup(s string, inParam bool) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "[", "&#91;")
+	s = strings.ReplaceAll(s, "]", "&#93;")
+	if inParam {
+		s = strings.ReplaceAll(s, ",", "&#44;")
+	}
+	return s
+}
+
+func UnescapeMarkup(s string) string {
+	s = strings.ReplaceAll(s, "&#44;", ",")
+	s = strings.ReplaceAll(s, "&#91;", "[")
+	s = strings.ReplaceAll(s, "&#93;", "]")
+	return strings.ReplaceAll(s, "&amp;", "&")
+}
+
+// ParseMarkup 将文本标记解析为待发送消息
+func ParseMarkup(s string) (*SendingMessage, error) {
+	elems, err := parseMarkupElems(s)
+	if err != nil {
+		return nil, err
+	}
+	return &SendingMessage{Elements: elems}, nil
+}
+
+func parseMarkupElems(s string) ([]IMessageElement, error) {
+	var r []IMessageElement
+	appendText := func(text string) {
+		if text == "" {
+			return
+		}
+		if len(r) != 0 {
+			if last, ok := r[len(r)-1].(*TextElement); ok {
+				last.Content += UnescapeMarkup(text)
+				return
+			}
+		}
+		r = append(r, NewText(UnescapeMarkup(text)))
+	}
+	for len(s) != 0 {
+		start := strings.IndexByte(s, '[')
+		if start == -1 {
+			start = len(s)
+		}
+		if end := strings.IndexByte(s[:start], ']'); end != -1 {
+			return nil, fmt.Errorf("unexpected ']' at %q", s[end:])
+		}
+		appendText(s[:start])
+		if start == len(s) {
+			break
+		}
+		end := strings.IndexByte(s[start:], ']')
+		if end == -1 {
+			return nil, fmt.Errorf("unclosed code %q", s[start:])
+		}
+		e, err := parseMarkupCode(s[start+1 : start+end])
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, e)
+		s = s[start+end+1:]
+	}
+	return r, nil
+}
+
+func parseMarkupCode(code string) (IMessageElement, error) {
+	name, params := code, ""
+	if i := strings.IndexByte(code, ':'); i != -1 {
+		name, params = code[:i], code[i+1:]
+	}
+	decoder, ok := markupDecoders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown code %q", name)
+	}
+	args := markupArgs{}
+	if params != "" {
+		for _, seg := range strings.Split(params, ",") {
+			i := strings.IndexByte(seg, '=')
+			if i == -1 {
+				key, ok := markupDefaultKeys[name]
+				if !ok {
+					return nil, fmt.Errorf("code %q requires key=value params", name)
+				}
+				args[key] = UnescapeMarkup(seg)
+				continue
+			}
+			args[seg[:i]] = UnescapeMarkup(seg[i+1:])
+		}
+	}
+	e, err := decoder(args)
+	if err != nil {
+		return nil, fmt.Errorf("code %q: %v", name, err)
+	}
+	return e, nil
+}
+
+// ToMarkup 将消息元素编码为文本标记, 无法表示的元素(如嵌套转发)会被忽略
+func ToMarkup(elems []IMessageElement) string {
+	var sb strings.Builder
+	for _, elem := range elems {
+		switch e := elem.(type) {
+		case *TextElement:
+			sb.WriteString(EscapeMarkup(e.Content, false))
+		case *AtElement:
+			qq := strconv.FormatInt(e.Target, 10)
+			if e.Target == 0 {
+				qq = "all"
+			}
+			writeMarkup(&sb, "at", "qq", qq, "display", e.Display)
+		case *FaceElement:
+			writeMarkup(&sb, "face", "id", e.Index)
+		case *ImageElement:
+			writeMarkup(&sb, "image", "file", e.Filename, "url", e.Url, "md5", e.Md5, "size", e.Size, "biz", e.ImageBizType, "effect", e.EffectId)
+		case *GroupImageElement:
+			writeMarkup(&sb, "image", "file", e.ImageId, "type", "group", "md5", e.Md5, "url", e.Url, "biz", e.ImageBizType)
+		case *FriendImageElement:
+			writeMarkup(&sb, "image", "file", e.ImageId, "type", "friend", "md5", e.Md5, "url", e.Url, "biz", e.ImageBizType)
+		case *FlashImageElement:
+			writeMarkup(&sb, "image", "file", e.Filename, "flash", 1, "url", e.Url, "md5", e.Md5, "size", e.Size, "biz", e.ImageBizType, "effect", e.EffectId)
+		case *GroupFlashImgElement:
+			writeMarkup(&sb, "image", "file", e.ImageId, "type", "group", "flash", 1, "md5", e.Md5, "url", e.Url, "biz", e.ImageBizType)
+		case *FriendFlashImgElement:
+			writeMarkup(&sb, "image", "file", e.ImageId, "type", "friend", "flash", 1, "md5", e.Md5, "url", e.Url, "biz", e.ImageBizType)
+		case *GroupShowPicElement:
+			writeMarkup(&sb, "image", "file", e.ImageId, "type", "group", "effect", e.EffectId, "md5", e.Md5, "url", e.Url, "biz", e.ImageBizType)
+		case *ReplyElement:
+			writeMarkup(&sb, "reply", "id", e.ReplySeq, "rand", e.InternalId, "group", e.GroupId, "sender", e.Sender, "time", e.Time, "content", ToMarkup(e.Elements))
+		case *ServiceElement:
+			writeMarkup(&sb, "service", "id", e.Id, "resid", e.ResId, "subtype", e.SubType, "content", e.Content)
+		case *ForwardElement:
+			writeMarkup(&sb, "forward", "resid", e.ResId, "file", e.FileName)
+		case *GroupFileElement:
+			writeMarkup(&sb, "file", "name", e.Name, "size", e.Size, "path", e.Path, "busid", e.Busid)
+		case *FriendFileElement:
+			writeMarkup(&sb, "file", "name", e.Name, "type", "friend", "size", e.Size, "uuid", e.Uuid, "md5", e.Md5, "expire", e.ExpireTime)
+		case *ShortVideoElement:
+			writeMarkup(&sb, "video", "uuid", e.Uuid, "name", e.Name, "size", e.Size, "thumbsize", e.ThumbSize, "md5", e.Md5, "thumbmd5", e.ThumbMd5, "duration", e.Duration, "width", e.Width, "height", e.Height)
+		case *LocationElement:
+			writeMarkup(&sb, "location", "name", e.Name, "address", e.Address, "lat", e.Latitude, "lon", e.Longitude)
+		case *MusicShareElement:
+			writeMarkup(&sb, "music", "title", e.Title, "type", e.MusicType, "summary", e.Summary, "url", e.Url, "picture", e.PictureUrl, "audio", e.MusicUrl)
+		case *LightAppElement:
+			writeMarkup(&sb, "json", "data", e.Content)
+		case *XmlElement:
+			writeMarkup(&sb, "xml", "data", e.Content, "id", e.ServiceId)
+		case *AnonymousElement:
+			writeMarkup(&sb, "anonymous", "id", e.AnonymousId, "nick", e.AnonymousNick, "portrait", e.HeadPortrait, "expire", e.ExpireTime, "bubble", e.BubbleId, "color", e.RankColor)
+		case *PokeElement:
+			writeMarkup(&sb, "poke", "name", e.Name, "type", e.PokeType, "id", e.Id)
+		case *DiceElement:
+			writeMarkup(&sb, "dice", "value", e.Value)
+		case *FingerGuessingElement:
+			writeMarkup(&sb, "rps", "value", e.Value)
+		case *MarketFaceElement:
+			writeMarkup(&sb, "mface", "name", e.Name, "id", e.FaceId, "tab", e.TabId, "item", e.ItemType, "sub", e.SubType, "media", e.MediaType, "key", e.EncryptKey, "magic", e.MagicValue)
+		}
+	}
+	return sb.String()
+}
+
+func (msg *PrivateMessage) ToMarkup() string {
+	return ToMarkup(msg.Elements)
+}
+
+func (msg *TempMessage) ToMarkup() string {
+	return ToMarkup(msg.Elements)
+}
+
+func (msg *GroupMessage) ToMarkup() string {
+	return ToMarkup(msg.Elements)
+}
+
+// writeMarkup 写入 [name:k=v,...], kv 为键值交替排列, 空值会被省略
+func writeMarkup(sb *strings.Builder, name string, kv ...interface{}) {
+	sb.WriteString("[" + name)
+	sep := ":"
+	for i := 0; i+1 < len(kv); i += 2 {
+		var v string
+		switch val := kv[i+1].(type) {
+		case string:
+			v = val
+		case []byte:
+			v = hex.EncodeToString(val)
+		case float64:
+			v = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			v = fmt.Sprint(val)
+		}
+		if v == "" {
+			continue
+		}
+		sb.WriteString(sep + kv[i].(string) + "=" + EscapeMarkup(v, true))
+		sep = ","
+	}
+	sb.WriteString("]")
+}
+
+func (a markupArgs) int64(key string) (int64, error) {
+	v, ok := a[key]
+	if !ok {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", key, err)
+	}
+	return i, nil
+}
+
+func (a markupArgs) int32(key string) (int32, error) {
+	i, err := a.int64(key)
+	return int32(i), err
+}
+
+func (a markupArgs) bytes(key string) ([]byte, error) {
+	v, ok := a[key]
+	if !ok {
+		return nil, nil
+	}
+	b, err := hex.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", key, err)
+	}
+	return b, nil
+}
+
+func (a markupArgs) float(key string) (float64, error) {
+	v, ok := a[key]
+	if !ok {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", key, err)
+	}
+	return f, nil
+}
+
+// collect 按目标类型解析多个参数, 返回第一个错误
+func (a markupArgs) collect(pairs map[string]interface{}) error {
+	for key, p := range pairs {
+		var err error
+		switch v := p.(type) {
+		case *int32:
+			*v, err = a.int32(key)
+		case *int64:
+			*v, err = a.int64(key)
+		case *[]byte:
+			*v, err = a.bytes(key)
+		case *float64:
+			*v, err = a.float(key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeAtMarkup(a markupArgs) (IMessageElement, error) {
+	qq := a["qq"]
+	if qq == "" {
+		return nil, errors.New("missing qq")
+	}
+	var target int64
+	if qq != "all" {
+		var err error
+		if target, err = a.int64("qq"); err != nil {
+			return nil, err
+		}
+	}
+	if d, ok := a["display"]; ok {
+		return NewAt(target, d), nil
+	}
+	return NewAt(target), nil
+}
+
+func decodeFaceMarkup(a markupArgs) (IMessageElement, error) {
+	id, err := a.int32("id")
+	if err != nil {
+		return nil, err
+	}
+	return NewFace(id), nil
+}
+
+func decodeImageMarkup(a markupArgs) (IMessageElement, error) {
+	var (
+		md5          []byte
+		size, effect int32
+		biz          int64
+	)
+	if err := a.collect(map[string]interface{}{"md5": &md5, "size": &size, "effect": &effect, "biz": &biz}); err != nil {
+		return nil, err
+	}
+	flash := a["flash"] == "1"
+	switch a["type"] {
+	case "group":
+		img := GroupImageElement{ImageId: a["file"], Md5: md5, Url: a["url"], ImageBizType: ImageBizType(biz)}
+		if flash {
+			return &GroupFlashImgElement{GroupImageElement: img}, nil
+		}
+		if effect != 0 {
+			return &GroupShowPicElement{GroupImageElement: img, EffectId: effect}, nil
+		}
+		return &img, nil
+	case "friend":
+		img := FriendImageElement{ImageId: a["file"], Md5: md5, Url: a["url"], ImageBizType: ImageBizType(biz)}
+		if flash {
+			return &FriendFlashImgElement{FriendImageElement: img}, nil
+		}
+		return &img, nil
+	case "":
+		img := ImageElement{Filename: a["file"], Size: size, Url: a["url"], Md5: md5, ImageBizType: ImageBizType(biz), EffectId: effect}
+		if flash {
+			return &FlashImageElement{ImageElement: img}, nil
+		}
+		return &img, nil
+	}
+	return nil, fmt.Errorf("unknown image type %q", a["type"])
+}
+
+func decodeReplyMarkup(a markupArgs) (IMessageElement, error) {
+	r := &ReplyElement{}
+	if err := a.collect(map[string]interface{}{"id": &r.ReplySeq, "rand": &r.InternalId, "group": &r.GroupId, "sender": &r.Sender, "time": &r.Time}); err != nil {
+		return nil, err
+	}
+	if content, ok := a["content"]; ok {
+		elems, err := parseMarkupElems(content)
+		if err != nil {
+			return nil, err
+		}
+		r.Elements = elems
+	}
+	return r, nil
+}
+
+func decodeServiceMarkup(a markupArgs) (IMessageElement, error) {
+	id, err := a.int32("id")
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceElement{Id: id, Content: a["content"], ResId: a["resid"], SubType: a["subtype"]}, nil
+}
+
+func decodeForwardMarkup(a markupArgs) (IMessageElement, error) {
+	return &ForwardElement{ResId: a["resid"], FileName: a["file"]}, nil
+}
+
+func decodeFileMarkup(a markupArgs) (IMessageElement, error) {
+	if a["type"] == "friend" {
+		f := &FriendFileElement{Name: a["name"]}
+		if err := a.collect(map[string]interface{}{"size": &f.Size, "uuid": &f.Uuid, "md5": &f.Md5, "expire": &f.ExpireTime}); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	f := &GroupFileElement{Name: a["name"], Path: a["path"]}
+	if err := a.collect(map[string]interface{}{"size": &f.Size, "busid": &f.Busid}); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func decodeVideoMarkup(a markupArgs) (IMessageElement, error) {
+	v := &ShortVideoElement{Name: a["name"]}
+	err := a.collect(map[string]interface{}{
+		"uuid": &v.Uuid, "size": &v.Size, "thumbsize": &v.ThumbSize,
+		"md5": &v.Md5, "thumbmd5": &v.ThumbMd5, "duration": &v.Duration,
+		"width": &v.Width, "height": &v.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func decodeLocationMarkup(a markupArgs) (IMessageElement, error) {
+	var lat, lon float64
+	if err := a.collect(map[string]interface{}{"lat": &lat, "lon": &lon}); err != nil {
+		return nil, err
+	}
+	return NewLocation(lat, lon, a["name"], a["address"]), nil
+}
+
+func decodeMusicMarkup(a markupArgs) (IMessageElement, error) {
+	t, err := a.int64("type")
+	if err != nil {
+		return nil, err
+	}
+	return &MusicShareElement{
+		MusicType:  int(t),
+		Title:      a["title"],
+		Summary:    a["summary"],
+		Url:        a["url"],
+		PictureUrl: a["picture"],
+		MusicUrl:   a["audio"],
+	}, nil
+}
+
+func decodeJsonMarkup(a markupArgs) (IMessageElement, error) {
+	return NewLightApp(a["data"]), nil
+}
+
+func decodeXmlMarkup(a markupArgs) (IMessageElement, error) {
+	id, err := a.int32("id")
+	if err != nil {
+		return nil, err
+	}
+	return NewXml(id, a["data"]), nil
+}
+
+func decodeAnonymousMarkup(a markupArgs) (IMessageElement, error) {
+	e := &AnonymousElement{}
+	e.AnonymousId, e.AnonymousNick, e.RankColor = a["id"], a["nick"], a["color"]
+	e.Flag = e.AnonymousId + "|" + e.AnonymousNick
+	if err := a.collect(map[string]interface{}{"portrait": &e.HeadPortrait, "expire": &e.ExpireTime, "bubble": &e.BubbleId}); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func decodePokeMarkup(a markupArgs) (IMessageElement, error) {
+	var t, id int32
+	if err := a.collect(map[string]interface{}{"type": &t, "id": &id}); err != nil {
+		return nil, err
+	}
+	return NewPoke(a["name"], t, id), nil
+}
+
+func decodeMarketFaceMarkup(a markupArgs) (IMessageElement, error) {
+	e := &MarketFaceElement{Name: a["name"], MagicValue: a["magic"]}
+	err := a.collect(map[string]interface{}{
+		"id": &e.FaceId, "tab": &e.TabId, "item": &e.ItemType,
+		"sub": &e.SubType, "media": &e.MediaType, "key": &e.EncryptKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func decodeDiceMarkup(a markupArgs) (IMessageElement, error) {
+	v, err := a.int32("value")
+	if err != nil {
+		return nil, err
+	}
+	return NewDice(v), nil
+}
+
+func decodeFingerGuessingMarkup(a markupArgs) (IMessageElement, error) {
+	v, err := a.int32("value")
+	if err != nil {
+		return nil, err
+	}
+	return NewFingerGuessing(v), nil
+}
@@ -0,0 +1,94 @@
+package message
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkupRoundTrip(t *testing.T) {
+	md5 := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	cases := []struct {
+		name  string
+		elems []IMessageElement
+	}{
+		{"Text", []IMessageElement{NewText("a[b]&c,d &#91; =")}},
+		{"Face", []IMessageElement{NewFace(14), NewFace(9999)}},
+		{"At", []IMessageElement{NewAt(123456), AtAll(), NewAt(654321, "@名字,带逗号")}},
+		{"Image", []IMessageElement{
+			&ImageElement{Filename: "{ABC}.png", Size: 1024, Url: "http://gchat.qpic.cn/a?b=1&c=2", Md5: md5, ImageBizType: StickerImage, EffectId: ShowPicShake},
+			&GroupImageElement{ImageId: "{ABC}.png", Md5: md5, Url: "http://x/y", ImageBizType: SelfieImage},
+			&FriendImageElement{ImageId: "/1234-abcd", Md5: md5},
+			&FlashImageElement{ImageElement: ImageElement{Filename: "f.jpg", Md5: md5}},
+			NewGroupFlashImage(&GroupImageElement{ImageId: "{F}.jpg", Md5: md5}),
+			NewFriendFlashImage(&FriendImageElement{ImageId: "/f", Md5: md5}),
+			NewGroupShowPic(&GroupImageElement{ImageId: "{S}.jpg", Md5: md5}, ShowPicLove),
+		}},
+		{"Reply", []IMessageElement{
+			&ReplyElement{ReplySeq: 1000, InternalId: -12345, GroupId: 111, Sender: 222, Time: 1600000000, Elements: []IMessageElement{
+				NewText("原消息[1],"), NewAt(333), NewFace(1),
+			}},
+			NewText("回复内容"),
+		}},
+		{"Service", []IMessageElement{NewUrlShare("https://example.com/?a=1,b=2", "标题", "内容", "https://example.com/a.png")}},
+		{"Forward", []IMessageElement{&ForwardElement{ResId: "abc/def", FileName: "1600000000"}}},
+		{"File", []IMessageElement{
+			&GroupFileElement{Name: "a,b.txt", Size: 2048, Path: "/abc", Busid: 102},
+			&FriendFileElement{Name: "c.pdf", Size: 4096, Uuid: []byte("/uuid-1"), Md5: md5, ExpireTime: 1600604800},
+		}},
+		{"Video", []IMessageElement{&ShortVideoElement{Name: "v.mp4", Uuid: []byte("uuid"), Size: 100, ThumbSize: 10, Md5: md5, ThumbMd5: md5, Duration: 15, Width: 720, Height: 1280}}},
+		{"Location", []IMessageElement{NewLocation(39.908823, 116.39747, "天安门", "北京市东城区")}},
+		{"Music", []IMessageElement{NewMusicShare(CloudMusic, "歌名", "歌手", "https://music.163.com/song?id=1", "https://p.png", "https://m.mp3")}},
+		{"LightApp", []IMessageElement{NewLightApp(`{"app":"com.tencent.map","meta":{"a":[1,2]}}`)}},
+		{"Xml", []IMessageElement{NewXml(1, `<?xml version='1.0'?><msg serviceID="1" brief="[卡片]"></msg>`)}},
+		{"Anonymous", []IMessageElement{NewAnonymous(&AnonymousInfo{
+			AnonymousId: "AQID", AnonymousNick: "匿名", HeadPortrait: 3, ExpireTime: 1600000000, BubbleId: 2, RankColor: "#fff", Flag: "AQID|匿名",
+		})}},
+		{"Poke", []IMessageElement{NewPoke("戳一戳", 1, -1)}},
+		{"MarketFace", []IMessageElement{
+			NewDice(5),
+			NewFingerGuessing(2),
+			&MarketFaceElement{Name: "[表情]", FaceId: md5, TabId: 1, ItemType: 6, SubType: 3, EncryptKey: []byte("key"), MagicValue: "rscType?1;value=1"},
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := ToMarkup(c.elems)
+			m, err := ParseMarkup(s)
+			if err != nil {
+				t.Fatalf("parse %q: %v", s, err)
+			}
+			if !reflect.DeepEqual(c.elems, m.Elements) {
+				t.Fatalf("round trip mismatch for %q\nwant: %#v\ngot:  %#v", s, c.elems, m.Elements)
+			}
+			if s2 := ToMarkup(m.Elements); s2 != s {
+				t.Fatalf("re-encode mismatch\nfirst:  %q\nsecond: %q", s, s2)
+			}
+		})
+	}
+}
+
+func TestMarkupShorthand(t *testing.T) {
+	m, err := ParseMarkup("[reply:1000][at:123] 你好[face:14][at:all]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []IMessageElement{&ReplyElement{ReplySeq: 1000}, NewAt(123), NewText(" 你好"), NewFace(14), AtAll()}
+	if !reflect.DeepEqual(want, m.Elements) {
+		t.Fatalf("want %#v, got %#v", want, m.Elements)
+	}
+}
+
+func TestMarkupInvalid(t *testing.T) {
+	for _, s := range []string{"[at:abc]", "[unknown:1]", "[face:1", "a]b", "[image:file=a,type=other]", "[location:lat=x]"} {
+		if _, err := ParseMarkup(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
+
+func TestGroupMessageToMarkup(t *testing.T) {
+	g := &GroupMessage{Elements: []IMessageElement{NewAt(1), NewText(" [hi]")}}
+	if s := g.ToMarkup(); s != "[at:qq=1,display=@1] &#91;hi&#93;" {
+		t.Fatalf("unexpected markup %q", s)
+	}
+}
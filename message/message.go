@@ -2,6 +2,7 @@ package message
 
 import (
 	"crypto/md5"
+	"encoding/base64"
 	"encoding/hex"
 	"github.com/Mrs4s/MiraiGo/binary"
 	"github.com/Mrs4s/MiraiGo/client/pb/msg"
@@ -16,53 +17,68 @@ import (
 
 type (
 	PrivateMessage struct {
-		Id         int32
-		InternalId int32
-		Target     int64
-		Time       int32
-		Sender     *Sender
-		Elements   []IMessageElement
+		Id         int32             `json:"Id"`
+		InternalId int32             `json:"InternalId"`
+		Target     int64             `json:"Target"`
+		Time       int32             `json:"Time"`
+		Sender     *Sender           `json:"Sender"`
+		Elements   []IMessageElement `json:"Elements"`
 	}
 
 	TempMessage struct {
-		Id        int32
-		GroupCode int64
-		GroupName string
-		Sender    *Sender
-		Elements  []IMessageElement
+		Id         int32             `json:"Id"`
+		InternalId int32             `json:"InternalId"`
+		GroupCode  int64             `json:"GroupCode"`
+		GroupName  string            `json:"GroupName"`
+		Time       int32             `json:"Time"`
+		Sender     *Sender           `json:"Sender"`
+		Elements   []IMessageElement `json:"Elements"`
 	}
 
 	GroupMessage struct {
-		Id         int32
-		InternalId int32
-		GroupCode  int64
-		GroupName  string
-		Sender     *Sender
-		Time       int32
-		Elements   []IMessageElement
+		Id            int32             `json:"Id"`
+		InternalId    int32             `json:"InternalId"`
+		GroupCode     int64             `json:"GroupCode"`
+		GroupName     string            `json:"GroupName"`
+		Sender        *Sender           `json:"Sender"`
+		Time          int32             `json:"Time"`
+		Elements      []IMessageElement `json:"Elements"`
+		AnonymousInfo *AnonymousInfo    `json:"AnonymousInfo"`
+		CatchUp       bool              `json:"CatchUp"` // 断线重连后补拉的消息
 		//OriginalElements []*msg.Elem
 	}
 
+	AnonymousInfo struct {
+		AnonymousId   string `json:"AnonymousId"`
+		AnonymousNick string `json:"AnonymousNick"`
+		HeadPortrait  int32  `json:"HeadPortrait"`
+		ExpireTime    int32  `json:"ExpireTime"`
+		BubbleId      int32  `json:"BubbleId"`
+		RankColor     string `json:"RankColor"`
+		Flag          string `json:"Flag"`
+	}
+
 	SendingMessage struct {
 		Elements []IMessageElement
+		Strategy SendStrategy
 	}
 
 	ForwardMessage struct {
-		Nodes []*ForwardNode
+		Nodes []*ForwardNode `json:"Nodes"`
 	}
 
 	ForwardNode struct {
-		SenderId   int64
-		SenderName string
-		Time       int32
-		Message    []IMessageElement
+		SenderId   int64             `json:"SenderId"`
+		SenderName string            `json:"SenderName"`
+		Time       int32             `json:"Time"`
+		Message    []IMessageElement `json:"Message"`
 	}
 
 	Sender struct {
-		Uin      int64
-		Nickname string
-		CardName string
-		IsFriend bool
+		Uin      int64  `json:"Uin"`
+		Nickname string `json:"Nickname"`
+		CardName string `json:"CardName"`
+		IsFriend bool   `json:"IsFriend"`
 	}
 
 	IMessageElement interface {
@@ -81,6 +97,14 @@ const (
 	Service
 	Forward
 	File
+	Video
+	Location
+	Music
+	LightApp
+	Xml
+	Anonymous
+	Poke
+	MarketFace
 )
 
 func (s *Sender) IsAnonymous() bool {
@@ -98,6 +122,8 @@ func (msg *PrivateMessage) ToString() (res string) {
 			res += e.Content
 		case *ImageElement:
 			res += "[Image:" + e.Filename + "]"
+		case *FlashImageElement:
+			res += "[FlashImage:" + e.Filename + "]"
 		case *FaceElement:
 			res += "[" + e.Name + "]"
 		case *AtElement:
@@ -114,6 +140,8 @@ func (msg *TempMessage) ToString() (res string) {
 			res += e.Content
 		case *ImageElement:
 			res += "[Image:" + e.Filename + "]"
+		case *FlashImageElement:
+			res += "[FlashImage:" + e.Filename + "]"
 		case *FaceElement:
 			res += "[" + e.Name + "]"
 		case *AtElement:
@@ -130,6 +158,8 @@ func (msg *GroupMessage) ToString() (res string) {
 			res += e.Content
 		case *ImageElement:
 			res += "[Image:" + e.Filename + "]"
+		case *FlashImageElement:
+			res += "[FlashImage:" + e.Filename + "]"
 		case *FaceElement:
 			res += "[" + e.Name + "]"
 		case *GroupImageElement:
@@ -183,7 +213,7 @@ func EstimateLength(elems []IMessageElement, limit int) int {
 			sum += utils.ChineseLength(e.Display, left)
 		case *ReplyElement:
 			sum += 444 + EstimateLength(e.Elements, left)
-		case *ImageElement, *GroupImageElement, *FriendImageElement:
+		case *ImageElement, *FlashImageElement, *GroupImageElement, *FriendImageElement, *GroupShowPicElement, *GroupFlashImgElement, *FriendFlashImgElement:
 			sum += 260
 		default:
 			sum += utils.ChineseLength(ToReadableString([]IMessageElement{elem}), left)
@@ -192,6 +222,24 @@ func EstimateLength(elems []IMessageElement, limit int) int {
 	return sum
 }
 
+func NewAnonymousInfo(id []byte, nick string) *AnonymousInfo {
+	info := &AnonymousInfo{
+		AnonymousId:   base64.StdEncoding.EncodeToString(id),
+		AnonymousNick: nick,
+	}
+	info.Flag = info.AnonymousId + "|" + nick
+	return info
+}
+
+// ParseAnonymousFlag 从 AnonymousInfo.Flag 中取出匿名 id 与昵称
+func ParseAnonymousFlag(flag string) (id, nick string, ok bool) {
+	i := strings.Index(flag, "|")
+	if i == -1 {
+		return "", "", false
+	}
+	return flag[:i], flag[i+1:], true
+}
+
 func (s *Sender) DisplayName() string {
 	if s.CardName == "" {
 		return s.Nickname
@@ -199,22 +247,58 @@ func (s *Sender) DisplayName() string {
 	return s.CardName
 }
 
+// ParseNotOnlineFile 解析私聊消息 RichText 中的离线文件
+func ParseNotOnlineFile(f *msg.NotOnlineFile) *FriendFileElement {
+	if f == nil || len(f.FileUuid) == 0 {
+		return nil
+	}
+	return &FriendFileElement{
+		Name:       string(f.FileName),
+		Size:       f.FileSize,
+		Uuid:       f.FileUuid,
+		Md5:        f.FileMd5,
+		ExpireTime: f.ExpireTime,
+	}
+}
+
+// ToNotOnlineFile 取出消息中的离线文件, 好友消息的文件需放在 RichText 中发送
+func ToNotOnlineFile(elems []IMessageElement) *msg.NotOnlineFile {
+	for _, elem := range elems {
+		if f, ok := elem.(*FriendFileElement); ok {
+			return &msg.NotOnlineFile{
+				FileType:   0,
+				FileUuid:   f.Uuid,
+				FileMd5:    f.Md5,
+				FileName:   []byte(f.Name),
+				FileSize:   f.Size,
+				Subcmd:     1,
+				ExpireTime: f.ExpireTime,
+			}
+		}
+	}
+	return nil
+}
+
 func ToProtoElems(elems []IMessageElement, generalFlags bool) (r []*msg.Elem) {
 	for _, elem := range elems {
 		if reply, ok := elem.(*ReplyElement); ok {
-			r = append(r, &msg.Elem{
-				SrcMsg: &msg.SourceMsg{
-					OrigSeqs:  []int32{reply.ReplySeq},
-					SenderUin: reply.Sender,
-					Time:      reply.Time,
-					Flag:      1,
-					Elems:     ToSrcProtoElems(reply.Elements),
-					RichMsg:   []byte{},
-					PbReserve: []byte{},
-					SrcMsg:    []byte{},
-					TroopName: []byte{},
-				},
-			})
+			src := &msg.SourceMsg{
+				OrigSeqs:  []int32{reply.ReplySeq},
+				SenderUin: reply.Sender,
+				Time:      reply.Time,
+				Flag:      1,
+				Elems:     ToSrcProtoElems(reply.Elements),
+				RichMsg:   []byte{},
+				PbReserve: []byte{},
+				SrcMsg:    []byte{},
+				TroopName: []byte{},
+			}
+			if reply.GroupId == 0 && reply.InternalId != 0 { // C2C 回复需要原消息的 uid
+				src.PbReserve, _ = proto.Marshal(&msg.SourceMsgResvAttr{
+					OrigUids: int64(uint32(reply.InternalId)) | 1<<56,
+				})
+			}
+			r = append(r, &msg.Elem{SrcMsg: src})
 		}
 	}
 	imgOld := []byte{0x15, 0x36, 0x20, 0x39, 0x32, 0x6B, 0x41, 0x31, 0x00, 0x38, 0x37, 0x32, 0x66, 0x30, 0x36, 0x36, 0x30, 0x33, 0x61, 0x65, 0x31, 0x30, 0x33, 0x62, 0x37, 0x20, 0x20, 0x20, 0x20, 0x20,
@@ -266,26 +350,103 @@ func ToProtoElems(elems []IMessageElement, generalFlags bool) (r []*msg.Elem) {
 				},
 			})
 		case *GroupImageElement:
+			r = append(r, &msg.Elem{CustomFace: groupImageFace(e, imgOld)})
+		case *FriendImageElement:
+			r = append(r, &msg.Elem{NotOnlineImage: friendImage(e)})
+		case *GroupShowPicElement:
+			face := groupImageFace(&e.GroupImageElement, imgOld)
+			face.Flag = []byte{0x11, 0x00, 0x00, 0x00}
+			face.PbReserve, _ = proto.Marshal(&msg.ResvAttr{
+				ImageBizType: int32(e.ImageBizType),
+				ImageShow: &msg.AnimationImageShow{
+					EffectId:       e.EffectId,
+					AnimationParam: []byte("{}"),
+				},
+			})
+			r = append(r, &msg.Elem{CustomFace: face})
+		case *PokeElement:
+			b, _ := proto.Marshal(&msg.MsgElemInfoServtype2{
+				PokeType:      e.PokeType,
+				VaspokeId:     e.Id,
+				VaspokeName:   e.Name,
+				VaspokeMinver: "7.2.0",
+			})
+			r = append(r, &msg.Elem{CommonElem: &msg.CommonElem{ServiceType: 2, PbElem: b, BusinessType: e.PokeType}})
+			r = append(r, &msg.Elem{Text: &msg.Text{Str: "[" + e.Name + "]请使用最新版手机QQ体验新功能。"}})
+		case *MarketFaceElement:
+			r = append(r, marketFaceElems(e)...)
+		case *DiceElement:
+			r = append(r, marketFaceElems(&e.MarketFaceElement)...)
+		case *FingerGuessingElement:
+			r = append(r, marketFaceElems(&e.MarketFaceElement)...)
+		case *GroupFlashImgElement:
+			b, _ := proto.Marshal(&msg.MsgElemInfoServtype3{FlashTroopPic: groupImageFace(&e.GroupImageElement, imgOld)})
+			r = append(r, &msg.Elem{CommonElem: &msg.CommonElem{ServiceType: 3, PbElem: b}})
+			r = append(r, &msg.Elem{Text: &msg.Text{Str: "[闪照]请使用新版手机QQ查看闪照。"}})
+		case *FriendFlashImgElement:
+			b, _ := proto.Marshal(&msg.MsgElemInfoServtype3{FlashC2CPic: friendImage(&e.FriendImageElement)})
+			r = append(r, &msg.Elem{CommonElem: &msg.CommonElem{ServiceType: 3, PbElem: b}})
+			r = append(r, &msg.Elem{Text: &msg.Text{Str: "[闪照]请使用新版手机QQ查看闪照。"}})
+		case *FlashImageElement: // 收到的闪照, 按来源还原为好友或群闪照
+			flash := &msg.MsgElemInfoServtype3{}
+			if strings.HasPrefix(e.Filename, "/") || strings.Contains(e.Url, "c2cpicdw.qpic.cn") {
+				flash.FlashC2CPic = friendImage(&FriendImageElement{ImageId: e.Filename, Md5: e.Md5, ImageBizType: e.ImageBizType})
+			} else {
+				flash.FlashTroopPic = groupImageFace(&GroupImageElement{ImageId: e.Filename, Md5: e.Md5, ImageBizType: e.ImageBizType}, imgOld)
+			}
+			b, _ := proto.Marshal(flash)
+			r = append(r, &msg.Elem{CommonElem: &msg.CommonElem{ServiceType: 3, PbElem: b}})
+			r = append(r, &msg.Elem{Text: &msg.Text{Str: "[闪照]请使用新版手机QQ查看闪照。"}})
+		case *ShortVideoElement:
+			w, h := e.Width, e.Height
+			if w == 0 || h == 0 {
+				w, h = 1280, 720
+			}
 			r = append(r, &msg.Elem{
-				CustomFace: &msg.CustomFace{
-					FilePath: e.ImageId,
-					Md5:      e.Md5[:],
-					Flag:     make([]byte, 4),
-					OldData:  imgOld,
+				Text: &msg.Text{
+					Str: "你的QQ暂不支持查看视频短片，请期待后续版本。",
 				},
 			})
-		case *FriendImageElement:
 			r = append(r, &msg.Elem{
-				NotOnlineImage: &msg.NotOnlineImage{
-					FilePath:     e.ImageId,
-					ResId:        e.ImageId,
-					OldPicMd5:    false,
-					PicMd5:       e.Md5,
-					DownloadPath: e.ImageId,
-					Original:     1,
-					PbReserve:    []byte{0x78, 0x02},
+				VideoFile: &msg.VideoFile{
+					FileUuid:               e.Uuid,
+					FileMd5:                e.Md5,
+					FileName:               []byte(hex.EncodeToString(e.Md5) + ".mp4"),
+					FileFormat:             3,
+					FileTime:               e.Duration,
+					FileSize:               e.Size,
+					ThumbWidth:             w,
+					ThumbHeight:            h,
+					ThumbFileMd5:           e.ThumbMd5,
+					ThumbFileSize:          e.ThumbSize,
+					FromChatType:           -1,
+					ToChatType:             -1,
+					BoolSupportProgressive: true,
+					FileWidth:              w,
+					FileHeight:             h,
 				},
 			})
+		case *AnonymousElement:
+			id, _ := base64.StdEncoding.DecodeString(e.AnonymousId)
+			r = append(r, &msg.Elem{
+				AnonGroupMsg: &msg.AnonymousGroupMessage{
+					Flags:        2,
+					AnonId:       id,
+					AnonNick:     []byte(e.AnonymousNick),
+					HeadPortrait: e.HeadPortrait,
+					ExpireTime:   e.ExpireTime,
+					BubbleId:     e.BubbleId,
+					RankColor:    []byte(e.RankColor),
+				},
+			})
+		case *LightAppElement:
+			r = append(r, lightAppElem(e.Content))
+		case *XmlElement:
+			r = append(r, richMsgElem(e.ServiceId, e.Content))
+		case *LocationElement:
+			r = append(r, lightAppElem(e.content()))
+		case *MusicShareElement:
+			r = append(r, richMsgElem(2, e.content()))
 		case *ServiceElement:
 			if e.Id == 35 {
 				r = append(r, &msg.Elem{
@@ -343,6 +504,24 @@ func ToProtoElems(elems []IMessageElement, generalFlags bool) (r []*msg.Elem) {
 	return
 }
 
+func lightAppElem(content string) *msg.Elem {
+	return &msg.Elem{
+		LightApp: &msg.LightAppElem{
+			Data: append([]byte{1}, binary.ZlibCompress([]byte(content))...),
+		},
+	}
+}
+
+func richMsgElem(serviceId int32, content string) *msg.Elem {
+	return &msg.Elem{
+		RichMsg: &msg.RichMsg{
+			Template1: append([]byte{1}, binary.ZlibCompress([]byte(content))...),
+			ServiceId: serviceId,
+			MsgResId:  []byte{},
+		},
+	}
+}
+
 func ToSrcProtoElems(elems []IMessageElement) (r []*msg.Elem) {
 	for _, elem := range elems {
 		switch e := elem.(type) {
@@ -361,7 +540,13 @@ func ToSrcProtoElems(elems []IMessageElement) (r []*msg.Elem) {
 
 func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 	var res []IMessageElement
+	var marketFaceName string // 商城表情后紧跟一个内容为表情名的文本 elem
 	for _, elem := range elems {
+		if elem.Text != nil && marketFaceName != "" && elem.Text.Str == marketFaceName {
+			marketFaceName = ""
+			continue
+		}
+		marketFaceName = ""
 		if elem.SrcMsg != nil {
 			if len(elem.SrcMsg.OrigSeqs) != 0 {
 				r := &ReplyElement{
@@ -370,6 +555,18 @@ func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 					Sender:   elem.SrcMsg.SenderUin,
 					Elements: ParseMessageElems(elem.SrcMsg.Elems),
 				}
+				if len(elem.SrcMsg.PbReserve) != 0 {
+					attr := msg.SourceMsgResvAttr{}
+					if err := proto.Unmarshal(elem.SrcMsg.PbReserve, &attr); err == nil {
+						r.InternalId = int32(attr.OrigUids)
+					}
+				}
+				if len(r.Elements) == 0 && len(elem.SrcMsg.SrcMsg) != 0 {
+					src := msg.Message{}
+					if err := proto.Unmarshal(elem.SrcMsg.SrcMsg, &src); err == nil && src.Body != nil && src.Body.RichText != nil {
+						r.Elements = ParseMessageElems(src.Body.RichText.Elems)
+					}
+				}
 				res = append(res, r)
 			}
 			continue
@@ -395,6 +592,45 @@ func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 				}
 			}
 		}
+		if elem.CommonElem != nil && elem.CommonElem.ServiceType == 2 {
+			poke := msg.MsgElemInfoServtype2{}
+			if err := proto.Unmarshal(elem.CommonElem.PbElem, &poke); err == nil {
+				res = append(res, NewPoke(poke.VaspokeName, poke.PokeType, poke.VaspokeId))
+				continue
+			}
+		}
+		if elem.MarketFace != nil {
+			res = append(res, parseMarketFace(elem.MarketFace))
+			marketFaceName = string(elem.MarketFace.FaceName)
+			continue
+		}
+		if elem.CommonElem != nil && elem.CommonElem.ServiceType == 3 {
+			flash := msg.MsgElemInfoServtype3{}
+			if err := proto.Unmarshal(elem.CommonElem.PbElem, &flash); err == nil {
+				if flash.FlashTroopPic != nil {
+					res = append(res, &FlashImageElement{ImageElement: *parseCustomFace(flash.FlashTroopPic)})
+					continue
+				}
+				if flash.FlashC2CPic != nil {
+					res = append(res, &FlashImageElement{ImageElement: *parseNotOnlineImage(flash.FlashC2CPic)})
+					continue
+				}
+			}
+		}
+		if elem.VideoFile != nil {
+			res = append(res, &ShortVideoElement{
+				Name:      string(elem.VideoFile.FileName),
+				Uuid:      elem.VideoFile.FileUuid,
+				Size:      elem.VideoFile.FileSize,
+				ThumbSize: elem.VideoFile.ThumbFileSize,
+				Md5:       elem.VideoFile.FileMd5,
+				ThumbMd5:  elem.VideoFile.ThumbFileMd5,
+				Duration:  elem.VideoFile.FileTime,
+				Width:     elem.VideoFile.FileWidth,
+				Height:    elem.VideoFile.FileHeight,
+			})
+			continue
+		}
 		if elem.LightApp != nil && len(elem.LightApp.Data) > 1 {
 			var content string
 			if elem.LightApp.Data[0] == 0 {
@@ -404,8 +640,12 @@ func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 				content = string(binary.ZlibUncompress(elem.LightApp.Data[1:]))
 			}
 			if content != "" {
-				// TODO: 解析具体的APP
-				return append(res, NewText(content))
+				if share := parseLightAppShare(content); share != nil {
+					res = append(res, share)
+					continue
+				}
+				res = append(res, NewLightApp(content))
+				continue
 			}
 		}
 		if elem.Text != nil {
@@ -428,42 +668,31 @@ func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 			}
 			if content != "" {
 				if elem.RichMsg.ServiceId == 35 {
-					reg := regexp.MustCompile(`m_resid="(\w+?.*?)"`)
-					res = append(res, &ForwardElement{ResId: reg.FindAllStringSubmatch(content, -1)[0][1]})
+					e := &ForwardElement{}
+					if sub := regexp.MustCompile(`m_resid="(.*?)"`).FindStringSubmatch(content); sub != nil {
+						e.ResId = sub[1]
+					}
+					if sub := regexp.MustCompile(`m_fileName="(.*?)"`).FindStringSubmatch(content); sub != nil {
+						e.FileName = sub[1]
+					}
+					res = append(res, e)
 					continue
 				}
 				if elem.RichMsg.ServiceId == 33 {
 					continue // 前面一个 elem 已经解析到链接
 				}
-				res = append(res, NewText(content))
+				if share := parseRichMsgShare(content); share != nil {
+					res = append(res, share)
+					continue
+				}
+				res = append(res, NewXml(elem.RichMsg.ServiceId, content))
 			}
 		}
 		if elem.CustomFace != nil {
-			res = append(res, &ImageElement{
-				Filename: elem.CustomFace.FilePath,
-				Size:     elem.CustomFace.Size,
-				Url: func() string {
-					if elem.CustomFace.OrigUrl == "" {
-						return "http://gchat.qpic.cn/gchatpic_new/0/0-0-" + strings.ReplaceAll(binary.CalculateImageResourceId(elem.CustomFace.Md5)[1:37], "-", "") + "/0?term=2"
-					}
-					return "http://gchat.qpic.cn" + elem.CustomFace.OrigUrl
-				}(),
-				Md5: elem.CustomFace.Md5,
-			})
+			res = append(res, parseCustomFace(elem.CustomFace))
 		}
 		if elem.NotOnlineImage != nil {
-			var img string
-			if elem.NotOnlineImage.OrigUrl != "" {
-				img = "http://c2cpicdw.qpic.cn" + elem.NotOnlineImage.OrigUrl
-			} else {
-				img = "http://c2cpicdw.qpic.cn/offpic_new/0/" + elem.NotOnlineImage.ResId + "/0?term=2"
-			}
-			res = append(res, &ImageElement{
-				Filename: elem.NotOnlineImage.FilePath,
-				Size:     elem.NotOnlineImage.FileLen,
-				Url:      img,
-				Md5:      elem.NotOnlineImage.PicMd5,
-			})
+			res = append(res, parseNotOnlineImage(elem.NotOnlineImage))
 		}
 		if elem.Face != nil {
 			res = append(res, NewFace(elem.Face.Index))
@@ -472,9 +701,164 @@ func ParseMessageElems(elems []*msg.Elem) []IMessageElement {
 	return res
 }
 
+func marketFaceElems(e *MarketFaceElement) []*msg.Elem {
+	return []*msg.Elem{
+		{
+			MarketFace: &msg.MarketFace{
+				FaceName:    []byte(e.Name),
+				ItemType:    e.ItemType,
+				FaceInfo:    1,
+				FaceId:      e.FaceId,
+				TabId:       e.TabId,
+				SubType:     e.SubType,
+				Key:         e.EncryptKey,
+				MediaType:   e.MediaType,
+				ImageWidth:  200,
+				ImageHeight: 200,
+				Mobileparam: []byte(e.MagicValue),
+			},
+		},
+		{Text: &msg.Text{Str: e.Name}},
+	}
+}
+
+func parseMarketFace(face *msg.MarketFace) IMessageElement {
+	e := MarketFaceElement{
+		Name:       string(face.FaceName),
+		FaceId:     face.FaceId,
+		TabId:      face.TabId,
+		ItemType:   face.ItemType,
+		SubType:    face.SubType,
+		MediaType:  face.MediaType,
+		EncryptKey: face.Key,
+		MagicValue: string(face.Mobileparam),
+	}
+	value := int32(-1)
+	if i := strings.LastIndex(e.MagicValue, "value="); i != -1 {
+		if v, err := strconv.ParseInt(e.MagicValue[i+6:], 10, 32); err == nil {
+			value = int32(v)
+		}
+	}
+	if value >= 0 {
+		switch e.TabId {
+		case diceTabId:
+			return &DiceElement{MarketFaceElement: e, Value: value + 1}
+		case fingerGuessingTabId:
+			return &FingerGuessingElement{MarketFaceElement: e, Value: value}
+		}
+	}
+	return &e
+}
+
+func groupImageFace(e *GroupImageElement, oldData []byte) *msg.CustomFace {
+	face := &msg.CustomFace{
+		FilePath: e.ImageId,
+		Md5:      e.Md5[:],
+		Flag:     make([]byte, 4),
+		OldData:  oldData,
+	}
+	if e.ImageBizType != UnknownBizType {
+		face.PbReserve, _ = proto.Marshal(&msg.ResvAttr{ImageBizType: int32(e.ImageBizType)})
+	}
+	return face
+}
+
+func friendImage(e *FriendImageElement) *msg.NotOnlineImage {
+	img := &msg.NotOnlineImage{
+		FilePath:     e.ImageId,
+		ResId:        e.ImageId,
+		OldPicMd5:    false,
+		PicMd5:       e.Md5,
+		DownloadPath: e.ImageId,
+		Original:     1,
+		PbReserve:    []byte{0x78, 0x02},
+	}
+	if e.ImageBizType != UnknownBizType {
+		b, _ := proto.Marshal(&msg.ResvAttr{ImageBizType: int32(e.ImageBizType)})
+		img.PbReserve = append(img.PbReserve, b...)
+	}
+	return img
+}
+
+func parseCustomFace(face *msg.CustomFace) *ImageElement {
+	img := &ImageElement{
+		Filename: face.FilePath,
+		Size:     face.Size,
+		Url: func() string {
+			if face.OrigUrl == "" {
+				return "http://gchat.qpic.cn/gchatpic_new/0/0-0-" + strings.ReplaceAll(binary.CalculateImageResourceId(face.Md5)[1:37], "-", "") + "/0?term=2"
+			}
+			return "http://gchat.qpic.cn" + face.OrigUrl
+		}(),
+		Md5: face.Md5,
+	}
+	img.ImageBizType, img.EffectId = parseImageReserve(face.PbReserve)
+	return img
+}
+
+func parseNotOnlineImage(e *msg.NotOnlineImage) *ImageElement {
+	var url string
+	if e.OrigUrl != "" {
+		url = "http://c2cpicdw.qpic.cn" + e.OrigUrl
+	} else {
+		url = "http://c2cpicdw.qpic.cn/offpic_new/0/" + e.ResId + "/0?term=2"
+	}
+	img := &ImageElement{
+		Filename: e.FilePath,
+		Size:     e.FileLen,
+		Url:      url,
+		Md5:      e.PicMd5,
+	}
+	img.ImageBizType, img.EffectId = parseImageReserve(e.PbReserve)
+	return img
+}
+
+func parseImageReserve(b []byte) (ImageBizType, int32) {
+	if len(b) == 0 {
+		return UnknownBizType, 0
+	}
+	attr := msg.ResvAttr{}
+	if err := proto.Unmarshal(b, &attr); err != nil {
+		return UnknownBizType, 0
+	}
+	if attr.ImageShow != nil {
+		return ImageBizType(attr.ImageBizType), attr.ImageShow.EffectId
+	}
+	return ImageBizType(attr.ImageBizType), 0
+}
+
 func (forMsg *ForwardMessage) CalculateValidationData(seq, random int32, groupCode int64) ([]byte, []byte) {
+	var items []*msg.PbMultiMsgItem
+	msgs := forMsg.packForwardMsg(seq, random, groupCode, &items)
+	buf, _ := proto.Marshal(&msg.PbMultiMsgNew{Msg: msgs})
+	trans := &msg.PbMultiMsgTransmit{Msg: msgs, PbItemList: append([]*msg.PbMultiMsgItem{
+		{
+			FileName: "MultiMsg",
+			Buffer:   buf,
+		},
+	}, items...)}
+	b, _ := proto.Marshal(trans)
+	data := binary.GZipCompress(b)
+	hash := md5.Sum(data)
+	return data, hash[:]
+}
+
+func (forMsg *ForwardMessage) packForwardMsg(seq, random int32, groupCode int64, items *[]*msg.PbMultiMsgItem) []*msg.Message {
 	var msgs []*msg.Message
 	for _, node := range forMsg.Nodes {
+		elems := make([]IMessageElement, 0, len(node.Message))
+		for _, e := range node.Message {
+			if nested, ok := e.(*ForwardMessage); ok { // 嵌套转发的内容放在单独的 item 中
+				fileName := utils.RandomString(32)
+				buf, _ := proto.Marshal(&msg.PbMultiMsgNew{Msg: nested.packForwardMsg(seq, random, groupCode, items)})
+				*items = append(*items, &msg.PbMultiMsgItem{
+					FileName: fileName,
+					Buffer:   buf,
+				})
+				e = nested.nestedTemplate(fileName)
+			}
+			elems = append(elems, e)
+		}
 		msgs = append(msgs, &msg.Message{
 			Head: &msg.MessageHead{
 				FromUin: node.SenderId,
@@ -494,22 +878,12 @@ func (forMsg *ForwardMessage) CalculateValidationData(seq, random int32, groupCo
 			},
 			Body: &msg.MessageBody{
 				RichText: &msg.RichText{
-					Elems: ToProtoElems(node.Message, false),
+					Elems: ToProtoElems(elems, false),
 				},
 			},
 		})
 	}
-	buf, _ := proto.Marshal(&msg.PbMultiMsgNew{Msg: msgs})
-	trans := &msg.PbMultiMsgTransmit{Msg: msgs, PbItemList: []*msg.PbMultiMsgItem{
-		{
-			FileName: "MultiMsg",
-			Buffer:   buf,
-		},
-	}}
-	b, _ := proto.Marshal(trans)
-	data := binary.GZipCompress(b)
-	hash := md5.Sum(data)
-	return data, hash[:]
+	return msgs
 }
 
 func ToReadableString(m []IMessageElement) (r string) {
@@ -525,6 +899,34 @@ func ToReadableString(m []IMessageElement) (r string) {
 			r += "[图片]"
 		case *AtElement:
 			r += e.Display
+		case *FlashImageElement, *GroupFlashImgElement, *FriendFlashImgElement:
+			r += "[闪照]"
+		case *GroupShowPicElement:
+			r += "[图片]"
+		case *PokeElement:
+			r += "[" + e.Name + "]"
+		case *MarketFaceElement:
+			r += e.Name
+		case *DiceElement:
+			r += "[骰子:" + strconv.FormatInt(int64(e.Value), 10) + "]"
+		case *FingerGuessingElement:
+			r += "[猜拳:" + e.ValueName() + "]"
+		case *ForwardElement, *ForwardMessage:
+			r += "[聊天记录]"
+		case *ShortVideoElement:
+			r += "[视频]"
+		case *GroupFileElement:
+			r += "[文件]" + e.Name
+		case *FriendFileElement:
+			r += "[文件]" + e.Name
+		case *LocationElement:
+			r += "[位置]" + e.Name
+		case *MusicShareElement:
+			r += "[分享]" + e.Title
+		case *LightAppElement:
+			r += "[应用]"
+		case *XmlElement:
+			r += "[卡片消息]"
 		}
 	}
 	return
@@ -0,0 +1,50 @@
+package message
+
+import (
+	"github.com/Mrs4s/MiraiGo/client/pb/msg"
+	"github.com/golang/protobuf/proto"
+	"testing"
+)
+
+func TestParseFlashImageWithLeadingElems(t *testing.T) {
+	md5 := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	flash, _ := proto.Marshal(&msg.MsgElemInfoServtype3{FlashTroopPic: &msg.CustomFace{FilePath: "{F}.jpg", Md5: md5}})
+	elems := ParseMessageElems([]*msg.Elem{
+		{SrcMsg: &msg.SourceMsg{OrigSeqs: []int32{100}, SenderUin: 123, Time: 1600000000, Elems: []*msg.Elem{{Text: &msg.Text{Str: "原消息"}}}}},
+		{Text: &msg.Text{Str: "看这个"}},
+		{CommonElem: &msg.CommonElem{ServiceType: 3, PbElem: flash}},
+	})
+	if len(elems) != 3 {
+		t.Fatalf("want 3 elements, got %d: %#v", len(elems), elems)
+	}
+	if r, ok := elems[0].(*ReplyElement); !ok || r.ReplySeq != 100 {
+		t.Fatalf("reply lost: %#v", elems[0])
+	}
+	if te, ok := elems[1].(*TextElement); !ok || te.Content != "看这个" {
+		t.Fatalf("text lost: %#v", elems[1])
+	}
+	if f, ok := elems[2].(*FlashImageElement); !ok || f.Filename != "{F}.jpg" {
+		t.Fatalf("flash image not parsed: %#v", elems[2])
+	}
+}
+
+func TestParseMarketFaceWithReply(t *testing.T) {
+	elems := ParseMessageElems([]*msg.Elem{
+		{SrcMsg: &msg.SourceMsg{OrigSeqs: []int32{100}, SenderUin: 123, Time: 1600000000}},
+		{MarketFace: &msg.MarketFace{FaceName: []byte("[表情]"), TabId: 1, ItemType: 6}},
+		{Text: &msg.Text{Str: "[表情]"}},
+		{Text: &msg.Text{Str: "后续"}},
+	})
+	if len(elems) != 3 {
+		t.Fatalf("want 3 elements, got %d: %#v", len(elems), elems)
+	}
+	if _, ok := elems[0].(*ReplyElement); !ok {
+		t.Fatalf("reply lost: %#v", elems[0])
+	}
+	if f, ok := elems[1].(*MarketFaceElement); !ok || f.Name != "[表情]" {
+		t.Fatalf("market face not parsed: %#v", elems[1])
+	}
+	if te, ok := elems[2].(*TextElement); !ok || te.Content != "后续" {
+		t.Fatalf("text after market face lost: %#v", elems[2])
+	}
+}
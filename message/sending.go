@@ -0,0 +1,139 @@
+package message
+
+import (
+	"errors"
+	"github.com/Mrs4s/MiraiGo/client/pb/msg"
+	"github.com/golang/protobuf/proto"
+	"strings"
+	"unicode/utf8"
+)
+
+// SendStrategy 消息超出单条限制时的发送策略
+type SendStrategy int
+
+const (
+	StrategyLong    SendStrategy = iota // 使用长消息发送, 超出长消息限制时转为合并转发
+	StrategySplit                       // 在句子边界拆分为多条消息依次发送
+	StrategyForward                     // 转为合并转发
+)
+
+const (
+	MessageSizeLimit      = 702 // 单条消息 protobuf 元素长度上限
+	MessageImageLimit     = 2
+	LongMessageSizeLimit  = 5000
+	LongMessageImageLimit = 50
+)
+
+// ErrElementTooLarge 单个非文本元素超出单条消息限制, 无法拆分发送
+var ErrElementTooLarge = errors.New("element exceeds message size limit")
+
+var sentenceEnds = []string{"\n", "。", "！", "？", "…", "!", "?", ". "}
+
+func (msg *SendingMessage) SetStrategy(s SendStrategy) *SendingMessage {
+	msg.Strategy = s
+	return msg
+}
+
+// Size 消息元素序列化后的实际长度
+func (msg *SendingMessage) Size() int {
+	return ElemsSize(msg.Elements)
+}
+
+func (msg *SendingMessage) ImageCount() int {
+	return msg.Count(func(e IMessageElement) bool { return e.Type() == Image })
+}
+
+// Exceeded 是否超出单条消息的限制
+func (msg *SendingMessage) Exceeded() bool {
+	return msg.ImageCount() > MessageImageLimit || msg.Size() > MessageSizeLimit
+}
+
+// ExceededLong 是否超出长消息的限制
+func (msg *SendingMessage) ExceededLong() bool {
+	return msg.ImageCount() > LongMessageImageLimit || msg.Size() > LongMessageSizeLimit
+}
+
+func ElemsSize(elems []IMessageElement) int {
+	return proto.Size(&msg.RichText{Elems: ToProtoElems(elems, false)})
+}
+
+// Split 将消息拆分为多条不超过单条限制的消息, 文本优先在句子边界处断开.
+// 存在单独也无法放入一条消息的元素时返回 ErrElementTooLarge
+func (msg *SendingMessage) Split() ([]*SendingMessage, error) {
+	var parts []*SendingMessage
+	cur := NewSendingMessage()
+	flush := func() {
+		if len(cur.Elements) != 0 {
+			parts = append(parts, cur)
+			cur = NewSendingMessage()
+		}
+	}
+	tryAppend := func(e IMessageElement) bool {
+		elems := make([]IMessageElement, len(cur.Elements), len(cur.Elements)+1)
+		copy(elems, cur.Elements)
+		_, isText := e.(*TextElement)
+		if t, ok := e.(*TextElement); ok && len(elems) != 0 {
+			if last, ok := elems[len(elems)-1].(*TextElement); ok { // 合并相邻文本
+				elems[len(elems)-1] = NewText(last.Content + t.Content)
+				e = nil
+			}
+		}
+		if e != nil {
+			if e.Type() == Image && cur.ImageCount() >= MessageImageLimit {
+				return false
+			}
+			elems = append(elems, e)
+		}
+		if (isText || len(cur.Elements) != 0) && ElemsSize(elems) > MessageSizeLimit { // 单个非文本元素无法再拆分
+			return false
+		}
+		cur.Elements = elems
+		return true
+	}
+	for _, e := range msg.Elements {
+		if tryAppend(e) {
+			continue
+		}
+		if t, ok := e.(*TextElement); ok {
+			for _, s := range splitSentences(t.Content, MessageSizeLimit-16) {
+				if !tryAppend(NewText(s)) {
+					flush()
+					if !tryAppend(NewText(s)) {
+						return nil, ErrElementTooLarge
+					}
+				}
+			}
+			continue
+		}
+		flush()
+		if !tryAppend(e) || ElemsSize(cur.Elements) > MessageSizeLimit {
+			return nil, ErrElementTooLarge
+		}
+	}
+	flush()
+	return parts, nil
+}
+
+// splitSentences 按句子拆分文本, 超过 limit 字节的句子按字符强制拆分
+func splitSentences(s string, limit int) (r []string) {
+	for len(s) != 0 {
+		end := len(s)
+		for _, sep := range sentenceEnds {
+			if i := strings.Index(s, sep); i != -1 && i+len(sep) < end {
+				end = i + len(sep)
+			}
+		}
+		sentence := s[:end]
+		s = s[end:]
+		for len(sentence) > limit {
+			cut := limit
+			for cut > 0 && !utf8.RuneStart(sentence[cut]) {
+				cut--
+			}
+			r = append(r, sentence[:cut])
+			sentence = sentence[cut:]
+		}
+		r = append(r, sentence)
+	}
+	return
+}
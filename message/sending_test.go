@@ -0,0 +1,63 @@
+package message
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSendingMessageSplit(t *testing.T) {
+	md5 := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	img := func() IMessageElement { return &GroupImageElement{ImageId: "{ABC}.png", Md5: md5} }
+	cases := []struct {
+		name   string
+		elems  []IMessageElement
+		parts  int // 0 表示只检查不超限
+		images int
+		err    error
+	}{
+		{"Short", []IMessageElement{NewText("你好")}, 1, 0, nil},
+		{"Sentences", []IMessageElement{NewText(strings.Repeat("这是一句比较长的话。", 60))}, 0, 0, nil},
+		{"NoBoundary", []IMessageElement{NewText(strings.Repeat("长", 1000))}, 0, 0, nil},
+		{"Images", []IMessageElement{img(), img(), img(), img(), img()}, 3, 5, nil},
+		{"Mixed", []IMessageElement{NewText(strings.Repeat("a", 600)), NewAt(123), img(), NewText(strings.Repeat("b", 600))}, 0, 1, nil},
+		{"TooLarge", []IMessageElement{NewText("前"), NewAt(123, "@"+strings.Repeat("名", 400))}, 0, 0, ErrElementTooLarge},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parts, err := (&SendingMessage{Elements: c.elems}).Split()
+			if err != c.err {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if c.parts != 0 && len(parts) != c.parts {
+				t.Fatalf("want %d parts, got %d", c.parts, len(parts))
+			}
+			var text, want strings.Builder
+			images := 0
+			for _, p := range parts {
+				if p.Exceeded() {
+					t.Fatalf("part exceeds limit: size %d, images %d", p.Size(), p.ImageCount())
+				}
+				images += p.ImageCount()
+				for _, e := range p.Elements {
+					if te, ok := e.(*TextElement); ok {
+						text.WriteString(te.Content)
+					}
+				}
+			}
+			for _, e := range c.elems {
+				if te, ok := e.(*TextElement); ok {
+					want.WriteString(te.Content)
+				}
+			}
+			if text.String() != want.String() {
+				t.Fatalf("text lost while splitting")
+			}
+			if images != c.images {
+				t.Fatalf("want %d images, got %d", c.images, images)
+			}
+		})
+	}
+}
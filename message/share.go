@@ -0,0 +1,189 @@
+package message
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/Mrs4s/MiraiGo/utils"
+	"strconv"
+)
+
+type musicSource struct {
+	Name        string
+	Icon        string
+	AndroidData string
+	IosData     string
+	AppId       int64
+}
+
+var musicSources = map[int]musicSource{
+	QQMusic: {
+		Name:        "QQ音乐",
+		Icon:        "https://i.gtimg.cn/open/app_icon/01/07/98/56/1101079856_100_m.png",
+		AndroidData: "com.tencent.qqmusic",
+		IosData:     "tencent1101079856://",
+		AppId:       1101079856,
+	},
+	CloudMusic: {
+		Name:        "网易云音乐",
+		Icon:        "https://pic.rmb.bdstatic.com/911423bee2bef937975b29b265d737b3.png",
+		AndroidData: "com.netease.cloudmusic",
+		IosData:     "tencent100495085://",
+		AppId:       100495085,
+	},
+	CustomMusic: {
+		Name:  "音乐",
+		AppId: -1,
+	},
+}
+
+type locationShareContent struct {
+	App    string `json:"app"`
+	Desc   string `json:"desc"`
+	View   string `json:"view"`
+	Ver    string `json:"ver"`
+	Prompt string `json:"prompt"`
+	From   int    `json:"from"`
+	Meta   struct {
+		Location struct {
+			Id      string `json:"id"`
+			Name    string `json:"name"`
+			Address string `json:"address"`
+			Lat     string `json:"lat"`
+			Lng     string `json:"lng"`
+			From    string `json:"from"`
+		} `json:"Location.Search"`
+	} `json:"meta"`
+	Config struct {
+		Forward  int    `json:"forward"`
+		AutoSize int    `json:"autosize"`
+		Type     string `json:"type"`
+	} `json:"config"`
+}
+
+// lightAppShare 仅用于解析, 字段类型在不同客户端间并不统一
+type lightAppShare struct {
+	App  string `json:"app"`
+	View string `json:"view"`
+	Meta struct {
+		Location *struct {
+			Name    string      `json:"name"`
+			Address string      `json:"address"`
+			Lat     json.Number `json:"lat"`
+			Lng     json.Number `json:"lng"`
+		} `json:"Location.Search"`
+		Music *struct {
+			AppId    json.Number `json:"appid"`
+			Title    string      `json:"title"`
+			Desc     string      `json:"desc"`
+			JumpUrl  string      `json:"jumpUrl"`
+			MusicUrl string      `json:"musicUrl"`
+			Preview  string      `json:"preview"`
+		} `json:"music"`
+	} `json:"meta"`
+}
+
+type richMsgShare struct {
+	ServiceId int    `xml:"serviceID,attr"`
+	Url       string `xml:"url,attr"`
+	Item      struct {
+		Audio struct {
+			Cover string `xml:"cover,attr"`
+			Src   string `xml:"src,attr"`
+		} `xml:"audio"`
+		Title   string `xml:"title"`
+		Summary string `xml:"summary"`
+	} `xml:"item"`
+	Source struct {
+		Name  string `xml:"name,attr"`
+		AppId string `xml:"appid,attr"`
+	} `xml:"source"`
+}
+
+func (e *LocationElement) content() string {
+	c := &locationShareContent{
+		App:    "com.tencent.map",
+		Desc:   "地图",
+		View:   "LocationShare",
+		Ver:    "0.0.0.1",
+		Prompt: "[应用]地图",
+		From:   1,
+	}
+	c.Meta.Location.Name = e.Name
+	c.Meta.Location.Address = e.Address
+	c.Meta.Location.Lat = strconv.FormatFloat(e.Latitude, 'f', -1, 64)
+	c.Meta.Location.Lng = strconv.FormatFloat(e.Longitude, 'f', -1, 64)
+	c.Meta.Location.From = "plusPanel"
+	c.Config.Forward = 1
+	c.Config.AutoSize = 1
+	c.Config.Type = "card"
+	b, _ := json.Marshal(c)
+	return string(b)
+}
+
+func (e *MusicShareElement) content() string {
+	src, ok := musicSources[e.MusicType]
+	if !ok {
+		src = musicSources[CustomMusic]
+	}
+	return fmt.Sprintf(`<?xml version='1.0' encoding='UTF-8' standalone='yes' ?><msg serviceID="2" templateID="1" action="web" brief="[分享] %s" sourceMsgId="0" url="%s" flag="0" adverSign="0" multiMsgFlag="0"><item layout="2"><audio cover="%s" src="%s"/><title>%s</title><summary>%s</summary></item><source name="%s" icon="%s" action="app" a_actionData="%s" i_actionData="%s" appid="%d" /></msg>`,
+		escapeXml(e.Title), escapeXml(e.Url), escapeXml(e.PictureUrl), escapeXml(e.MusicUrl), escapeXml(e.Title), escapeXml(e.Summary),
+		src.Name, src.Icon, src.AndroidData, src.IosData, src.AppId,
+	)
+}
+
+func parseLightAppShare(content string) IMessageElement {
+	var s lightAppShare
+	if err := json.Unmarshal([]byte(content), &s); err != nil {
+		return nil
+	}
+	if s.App == "com.tencent.map" && s.Meta.Location != nil {
+		lat, _ := s.Meta.Location.Lat.Float64()
+		lng, _ := s.Meta.Location.Lng.Float64()
+		return NewLocation(lat, lng, s.Meta.Location.Name, s.Meta.Location.Address)
+	}
+	if s.View == "music" && s.Meta.Music != nil {
+		appId, _ := s.Meta.Music.AppId.Int64()
+		return &MusicShareElement{
+			MusicType:  musicTypeOf(appId),
+			Title:      s.Meta.Music.Title,
+			Summary:    s.Meta.Music.Desc,
+			Url:        s.Meta.Music.JumpUrl,
+			PictureUrl: s.Meta.Music.Preview,
+			MusicUrl:   s.Meta.Music.MusicUrl,
+		}
+	}
+	return nil
+}
+
+func parseRichMsgShare(content string) IMessageElement {
+	var s richMsgShare
+	if err := xml.Unmarshal([]byte(content), &s); err != nil {
+		return nil
+	}
+	if s.ServiceId == 2 && s.Item.Audio.Src != "" {
+		appId, _ := strconv.ParseInt(s.Source.AppId, 10, 64)
+		return &MusicShareElement{
+			MusicType:  musicTypeOf(appId),
+			Title:      s.Item.Title,
+			Summary:    s.Item.Summary,
+			Url:        s.Url,
+			PictureUrl: s.Item.Audio.Cover,
+			MusicUrl:   s.Item.Audio.Src,
+		}
+	}
+	return nil
+}
+
+func musicTypeOf(appId int64) int {
+	for t, src := range musicSources {
+		if t != CustomMusic && src.AppId == appId {
+			return t
+		}
+	}
+	return CustomMusic
+}
+
+func escapeXml(s string) string {
+	return utils.XmlEscape(s)
+}
@@ -15,6 +15,39 @@ func HttpGetBytes(url string) ([]byte, error) {
 	}
 	req.Header["User-Agent"] = []string{"QQ/8.2.0.1296 CFNetwork/1126"}
 	req.Header["Net-Type"] = []string{"Wifi"}
+	return doRequest(req)
+}
+
+// HttpGetBytesWithCookie 带登录 cookie 请求 qun.qq.com 等 web 接口
+func HttpGetBytesWithCookie(url, cookie string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header["User-Agent"] = []string{"QQ/8.2.0.1296 CFNetwork/1126"}
+	req.Header["Net-Type"] = []string{"Wifi"}
+	req.Header["Cookie"] = []string{cookie}
+	return doRequest(req)
+}
+
+func HttpPostBytesWithCookie(url string, data []byte, cookie string, contentType ...string) ([]byte, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header["User-Agent"] = []string{"QQ/8.2.0.1296 CFNetwork/1126"}
+	req.Header["Net-Type"] = []string{"Wifi"}
+	req.Header["Content-Type"] = []string{"application/json"}
+	if len(contentType) > 0 {
+		req.Header["Content-Type"] = contentType
+	}
+	if cookie != "" {
+		req.Header["Cookie"] = []string{cookie}
+	}
+	return doRequest(req)
+}
+
+func doRequest(req *http.Request) ([]byte, error) {
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
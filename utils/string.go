@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/xml"
 	"math/big"
 )
 
@@ -41,3 +42,10 @@ func ChineseLength(str string, limit int) int {
 	}
 	return sum
 }
+
+// XmlEscape 转义用于 xml 卡片模板的文本
+func XmlEscape(s string) string {
+	buf := new(bytes.Buffer)
+	_ = xml.EscapeText(buf, []byte(s))
+	return buf.String()
+}
@@ -40,16 +40,33 @@ func NewTTList(ttl int64) *TTList {
 
 func (l *TTList) Add(i interface{}) {
 	l.lock.Lock()
-	l.lock.Unlock()
+	defer l.lock.Unlock()
 	l.list = append(l.list, &item{
 		i:          i,
 		lastAccess: time.Now().Unix(),
 	})
 }
 
+// AddIfAbsent 不存在满足 filter 的元素时添加 i, 检查与添加在同一次加锁内完成. 添加成功时返回 true
+func (l *TTList) AddIfAbsent(i interface{}, filter func(i interface{}) bool) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, it := range l.list {
+		if filter(it.i) {
+			it.lastAccess = time.Now().Unix()
+			return false
+		}
+	}
+	l.list = append(l.list, &item{
+		i:          i,
+		lastAccess: time.Now().Unix(),
+	})
+	return true
+}
+
 func (l *TTList) Any(filter func(i interface{}) bool) bool {
 	l.lock.Lock()
-	l.lock.Unlock()
+	defer l.lock.Unlock()
 	for _, it := range l.list {
 		if filter(it.i) {
 			it.lastAccess = time.Now().Unix()
@@ -58,3 +75,15 @@ func (l *TTList) Any(filter func(i interface{}) bool) bool {
 	}
 	return false
 }
+
+func (l *TTList) First(filter func(i interface{}) bool) interface{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, it := range l.list {
+		if filter(it.i) {
+			it.lastAccess = time.Now().Unix()
+			return it.i
+		}
+	}
+	return nil
+}